@@ -16,6 +16,7 @@ package options
 import (
 	"errors"
 	"fmt"
+	"net"
 	"time"
 
 	genericoptions "github.com/ArthurWang23/miniblog/pkg/options"
@@ -25,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/ArthurWang23/miniblog/internal/apiserver"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/moderation"
 )
 
 // 定义支持的服务器模式集合
@@ -52,18 +54,44 @@ type ServerOptions struct {
 	MySQLOptions *genericoptions.MySQLOptions `json:"mysql" mapstructure:"mysql"`
 
 	TLSOptions *genericoptions.TLSOptions `json:"tls" mapstructure:"tls"`
+
+	// PublishInterval定义检查并发布到期定时博文的时间间隔
+	PublishInterval time.Duration `json:"publish-interval" mapstructure:"publish-interval"`
+
+	// PostRetention定义已删除的博文在回收站中的保留时间
+	PostRetention time.Duration `json:"post-retention" mapstructure:"post-retention"`
+
+	// MediaDir定义保存上传文件的本地目录
+	MediaDir string `json:"media-dir" mapstructure:"media-dir"`
+
+	// MediaMaxSize定义上传文件的最大字节数
+	MediaMaxSize int64 `json:"media-max-size" mapstructure:"media-max-size"`
+
+	// ModerationOptions定义创建和更新博文时的内容审核规则
+	ModerationOptions *moderation.Options `json:"moderation" mapstructure:"moderation"`
+
+	// TrustedProxies定义可信代理的IP或CIDR，只有来自可信代理的请求才使用X-Forwarded-For中的客户端IP
+	TrustedProxies []string `json:"trusted-proxies" mapstructure:"trusted-proxies"`
 }
 
 // 创建ServerOptions的默认配置
 func NewServerOptions() *ServerOptions {
 	opts := &ServerOptions{
-		ServerMode:   apiserver.GRPCGatewayServerMode,
-		JWTKey:       "Rtg8BPKNEf2mB4mgvKONGPZZQSaJWNLijxR42qRgq0iBb5",
-		Expiration:   2 * time.Hour,
-		GRPCOptions:  genericoptions.NewGRPCOptions(),
-		HTTPOptions:  genericoptions.NewHTTPOptions(),
-		MySQLOptions: genericoptions.NewMySQLOptions(),
-		TLSOptions:   genericoptions.NewTLSOptions(),
+		ServerMode:      apiserver.GRPCGatewayServerMode,
+		JWTKey:          "Rtg8BPKNEf2mB4mgvKONGPZZQSaJWNLijxR42qRgq0iBb5",
+		Expiration:      2 * time.Hour,
+		GRPCOptions:     genericoptions.NewGRPCOptions(),
+		HTTPOptions:     genericoptions.NewHTTPOptions(),
+		MySQLOptions:    genericoptions.NewMySQLOptions(),
+		TLSOptions:      genericoptions.NewTLSOptions(),
+		PublishInterval: 30 * time.Second,
+		PostRetention:   30 * 24 * time.Hour,
+		MediaDir:        "_output/media",
+		MediaMaxSize:    10 << 20,
+		// grpc-gateway模式下，网关通过本机地址访问grpc服务
+		TrustedProxies: []string{"127.0.0.1", "::1"},
+
+		ModerationOptions: moderation.NewOptions(),
 	}
 	opts.GRPCOptions.Addr = ":6666"
 	opts.HTTPOptions.Addr = ":5555"
@@ -75,10 +103,16 @@ func (o *ServerOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.ServerMode, "server-mode", o.ServerMode, fmt.Sprintf("Server mode,available options: %v", availableServerModes.UnsortedList()))
 	fs.StringVar(&o.JWTKey, "jwt-key", o.JWTKey, "JWT signing key. Must be at least 6 characters long.")
 	fs.DurationVar(&o.Expiration, "expiration", o.Expiration, "The expiration duration of JWT tokens.")
+	fs.DurationVar(&o.PublishInterval, "publish-interval", o.PublishInterval, "The interval for publishing scheduled posts.")
+	fs.DurationVar(&o.PostRetention, "post-retention", o.PostRetention, "How long deleted posts are kept before being purged.")
+	fs.StringVar(&o.MediaDir, "media-dir", o.MediaDir, "The directory where uploaded media files are stored.")
+	fs.Int64Var(&o.MediaMaxSize, "media-max-size", o.MediaMaxSize, "The maximum size in bytes of an uploaded media file.")
+	fs.StringSliceVar(&o.TrustedProxies, "trusted-proxies", o.TrustedProxies, "IPs or CIDRs of proxies whose X-Forwarded-For header is trusted.")
 	o.GRPCOptions.AddFlags(fs)
 	o.HTTPOptions.AddFlags(fs)
 	o.MySQLOptions.AddFlags(fs)
 	o.TLSOptions.AddFlags(fs)
+	o.ModerationOptions.AddFlags(fs)
 }
 
 // Validate校验ServerOptions中的选项是否合法
@@ -92,9 +126,34 @@ func (o *ServerOptions) Validate() error {
 		errs = append(errs, errors.New("JWT key must be at least 6 characters long"))
 	}
 
+	if o.PublishInterval <= 0 {
+		errs = append(errs, errors.New("publish interval must be greater than 0"))
+	}
+
+	if o.PostRetention <= 0 {
+		errs = append(errs, errors.New("post retention must be greater than 0"))
+	}
+
+	if o.MediaDir == "" {
+		errs = append(errs, errors.New("media dir cannot be empty"))
+	}
+
+	if o.MediaMaxSize <= 0 {
+		errs = append(errs, errors.New("media max size must be greater than 0"))
+	}
+
+	for _, proxy := range o.TrustedProxies {
+		if net.ParseIP(proxy) == nil {
+			if _, _, err := net.ParseCIDR(proxy); err != nil {
+				errs = append(errs, fmt.Errorf("invalid trusted proxy %q: must be an IP or CIDR", proxy))
+			}
+		}
+	}
+
 	errs = append(errs, o.TLSOptions.Validate()...)
 	errs = append(errs, o.HTTPOptions.Validate()...)
 	errs = append(errs, o.MySQLOptions.Validate()...)
+	errs = append(errs, o.ModerationOptions.Validate()...)
 	if stringsutil.StringIn(o.ServerMode, []string{apiserver.GRPCServerMode, apiserver.GRPCGatewayServerMode}) {
 		errs = append(errs, o.GRPCOptions.Validate()...)
 	}
@@ -106,12 +165,18 @@ func (o *ServerOptions) Validate() error {
 // 注意：导入了运行时代码包，控制面依赖数据面，要避免反向导入循环依赖
 func (o *ServerOptions) Config() (*apiserver.Config, error) {
 	return &apiserver.Config{
-		ServerMode:   o.ServerMode,
-		JWTKey:       o.JWTKey,
-		Expiration:   o.Expiration,
-		GRPCOptions:  o.GRPCOptions,
-		HTTPOptions:  o.HTTPOptions,
-		MySQLOptions: o.MySQLOptions,
-		TLSOptions:   o.TLSOptions,
+		ServerMode:      o.ServerMode,
+		JWTKey:          o.JWTKey,
+		Expiration:      o.Expiration,
+		GRPCOptions:     o.GRPCOptions,
+		HTTPOptions:     o.HTTPOptions,
+		MySQLOptions:    o.MySQLOptions,
+		TLSOptions:      o.TLSOptions,
+		PublishInterval: o.PublishInterval,
+		PostRetention:   o.PostRetention,
+		MediaDir:        o.MediaDir,
+		MediaMaxSize:    o.MediaMaxSize,
+		Moderation:      o.ModerationOptions,
+		TrustedProxies:  o.TrustedProxies,
 	}, nil
 }
@@ -0,0 +1,275 @@
+// Copyright 2025 ArthurWang &lt;2826979176@qq.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/arthurwang23/miniblog. The professional
+// version of this repository is https://github.com/arthurwang23/miniblog.
+
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"github.com/ArthurWang23/miniblog/cmd/mb-apiserver/app/options"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/biz"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/postio"
+	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
+	"github.com/ArthurWang23/miniblog/internal/pkg/known"
+	"github.com/ArthurWang23/miniblog/internal/pkg/log"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// 博文导入导出命令，直接连接数据库，不经过API服务器
+// mb-apiserver export posts --format jsonl -o posts.jsonl
+// mb-apiserver import posts --format markdown ./posts/
+
+// Markdown格式导出时文件名中不允许出现的字符
+var unsafeFilenameChars = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+func newExportCommand(opts *options.ServerOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export data from the miniblog database",
+		Args:  cobra.NoArgs,
+	}
+	var format, output, username string
+	postsCmd := &cobra.Command{
+		Use:   "posts",
+		Short: "Export posts as JSON Lines or Markdown files",
+		Long: `Export posts as JSON Lines or Markdown files.
+The jsonl format writes one post per line to the output file, or to stdout if the output is "-".
+The markdown format writes one <externalID>.md file per post to the output directory.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := postio.ParseFormat(format)
+			if err != nil {
+				return err
+			}
+			if f == postio.Markdown && output == "-" {
+				return errors.New("--output must be a directory when exporting markdown files")
+			}
+			b, err := newCommandBiz(opts)
+			if err != nil {
+				return err
+			}
+			defer log.Sync()
+
+			send, closeFn, err := exportWriter(f, output)
+			if err != nil {
+				return err
+			}
+			var count int
+			err = b.PostV1().Export(commandContext(), &apiv1.ExportPostsRequest{Format: string(f), Username: username}, func(resp *apiv1.ExportPostsResponse) error {
+				count++
+				return send(resp)
+			})
+			if cerr := closeFn(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "exported %d posts\n", count)
+			return nil
+		},
+	}
+	postsCmd.Flags().StringVar(&format, "format", string(postio.JSONL), "Export format, one of jsonl, markdown.")
+	postsCmd.Flags().StringVarP(&output, "output", "o", "-", "Output file for jsonl, or output directory for markdown.")
+	postsCmd.Flags().StringVar(&username, "username", "", "Only export posts of the specified user.")
+	cmd.AddCommand(postsCmd)
+	return cmd
+}
+
+// exportWriter 返回写入导出博文的函数，以及导出结束后关闭输出的函数
+func exportWriter(format postio.Format, output string) (func(*apiv1.ExportPostsResponse) error, func() error, error) {
+	if format == postio.Markdown {
+		if err := os.MkdirAll(output, 0o755); err != nil {
+			return nil, nil, err
+		}
+		send := func(resp *apiv1.ExportPostsResponse) error {
+			name := unsafeFilenameChars.ReplaceAllString(resp.GetExternalID(), "_") + ".md"
+			return os.WriteFile(filepath.Join(output, name), resp.GetData(), 0o644)
+		}
+		return send, func() error { return nil }, nil
+	}
+
+	var w io.Writer = os.Stdout
+	closeFn := func() error { return nil }
+	if output != "-" {
+		file, err := os.Create(output)
+		if err != nil {
+			return nil, nil, err
+		}
+		w, closeFn = file, file.Close
+	}
+	bw := bufio.NewWriter(w)
+	send := func(resp *apiv1.ExportPostsResponse) error {
+		if _, err := bw.Write(resp.GetData()); err != nil {
+			return err
+		}
+		return bw.WriteByte('\n')
+	}
+	flushAndClose := func() error {
+		if err := bw.Flush(); err != nil {
+			_ = closeFn()
+			return err
+		}
+		return closeFn()
+	}
+	return send, flushAndClose, nil
+}
+
+func newImportCommand(opts *options.ServerOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import data into the miniblog database",
+		Args:  cobra.NoArgs,
+	}
+	var format, author string
+	postsCmd := &cobra.Command{
+		Use:   "posts PATH...",
+		Short: "Import posts from JSON Lines or Markdown files",
+		Long: `Import posts from JSON Lines or Markdown files.
+Posts are matched by author and externalID, importing the same data again updates the existing posts.
+For the jsonl format each PATH is a file with one post per line, use "-" to read from stdin.
+For the markdown format each PATH is a .md file or a directory containing .md files.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := postio.ParseFormat(format)
+			if err != nil {
+				return err
+			}
+			next, err := importReader(f, args)
+			if err != nil {
+				return err
+			}
+			b, err := newCommandBiz(opts)
+			if err != nil {
+				return err
+			}
+			defer log.Sync()
+
+			resp, err := b.PostV1().Import(commandContext(), string(f), author, next)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stdout, "created: %d, updated: %d, failed: %d\n", resp.GetCreated(), resp.GetUpdated(), resp.GetFailed())
+			for _, e := range resp.GetErrors() {
+				fmt.Fprintf(os.Stdout, "  #%d %s: %s\n", e.GetIndex(), e.GetExternalID(), e.GetMessage())
+			}
+			if resp.GetFailed() > 0 {
+				return fmt.Errorf("failed to import %d posts", resp.GetFailed())
+			}
+			return nil
+		},
+	}
+	postsCmd.Flags().StringVar(&format, "format", string(postio.JSONL), "Import format, one of jsonl, markdown.")
+	postsCmd.Flags().StringVar(&author, "author", "", "Username used for posts that do not specify an author.")
+	cmd.AddCommand(postsCmd)
+	return cmd
+}
+
+// importReader 返回依次读取待导入博文的函数，读取完毕时返回io.EOF
+func importReader(format postio.Format, paths []string) (func() ([]byte, error), error) {
+	if format == postio.Markdown {
+		var files []string
+		for _, path := range paths {
+			info, err := os.Stat(path)
+			if err != nil {
+				return nil, err
+			}
+			if !info.IsDir() {
+				files = append(files, path)
+				continue
+			}
+			matches, err := filepath.Glob(filepath.Join(path, "*.md"))
+			if err != nil {
+				return nil, err
+			}
+			sort.Strings(matches)
+			files = append(files, matches...)
+		}
+		return func() ([]byte, error) {
+			if len(files) == 0 {
+				return nil, io.EOF
+			}
+			file := files[0]
+			files = files[1:]
+			return os.ReadFile(file)
+		}, nil
+	}
+
+	var current *bufio.Reader
+	var closeFn func() error
+	return func() ([]byte, error) {
+		for {
+			if current == nil {
+				if len(paths) == 0 {
+					return nil, io.EOF
+				}
+				path := paths[0]
+				paths = paths[1:]
+				if path == "-" {
+					current, closeFn = bufio.NewReader(os.Stdin), func() error { return nil }
+				} else {
+					file, err := os.Open(path)
+					if err != nil {
+						return nil, err
+					}
+					current, closeFn = bufio.NewReader(file), file.Close
+				}
+			}
+			line, err := current.ReadBytes('\n')
+			if err != nil && !errors.Is(err, io.EOF) {
+				return nil, err
+			}
+			if errors.Is(err, io.EOF) {
+				current = nil
+				_ = closeFn()
+			}
+			// 跳过空行
+			if line = bytes.TrimSpace(line); len(line) > 0 {
+				return line, nil
+			}
+		}
+	}, nil
+}
+
+// newCommandBiz 按照配置文件和命令行选项创建业务层实例
+// 日志输出到标准错误，避免与输出到标准输出的导出数据混在一起
+func newCommandBiz(opts *options.ServerOptions) (biz.IBiz, error) {
+	logOpts := logOptions()
+	for i, path := range logOpts.OutputPaths {
+		if path == "stdout" {
+			logOpts.OutputPaths[i] = "stderr"
+		}
+	}
+	log.Init(logOpts)
+
+	if err := viper.Unmarshal(opts); err != nil {
+		return nil, err
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	cfg, err := opts.Config()
+	if err != nil {
+		return nil, err
+	}
+	return cfg.NewBiz()
+}
+
+// commandContext 命令行工具以管理员身份执行
+func commandContext() context.Context {
+	return contextx.WithUsername(context.Background(), known.AdminUsername)
+}
@@ -61,6 +61,9 @@ The project features include:
 	opts.AddFlags(cmd.PersistentFlags())
 
 	version.AddFlags(cmd.PersistentFlags())
+
+	// 博文导入导出子命令
+	cmd.AddCommand(newExportCommand(opts), newImportCommand(opts))
 	return cmd
 }
 
@@ -22,6 +22,35 @@ CREATE DATABASE /*!32312 IF NOT EXISTS*/ `miniblog` /*!40100 DEFAULT CHARACTER S
 
 USE `miniblog`;
 
+--
+-- Table structure for table `bookmark`
+--
+
+DROP TABLE IF EXISTS `bookmark`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `bookmark` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '收藏用户唯一 ID',
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `note` varchar(1024) NOT NULL DEFAULT '' COMMENT '收藏备注，仅收藏用户可见',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '收藏时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '备注最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `bookmark.userID_postID` (`userID`,`postID`),
+  KEY `idx.bookmark.postID` (`postID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文收藏表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `bookmark`
+--
+
+LOCK TABLES `bookmark` WRITE;
+/*!40000 ALTER TABLE `bookmark` DISABLE KEYS */;
+/*!40000 ALTER TABLE `bookmark` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `casbin_rule`
 --
@@ -55,15 +84,147 @@ INSERT INTO `casbin_rule` VALUES
 (7,'p','role::user','/v1.MiniBlog/DeleteUser','CALL','deny','',''),
 (8,'p','role::user','/v1.MiniBlog/ListUser','CALL','deny','',''),
 (9,'p','role::user','/v1/users','GET','deny','',''),
-(10,'p','role::user','/v1/users/*','DELETE','deny','','');
+(10,'p','role::user','/v1/users/*','DELETE','deny','',''),
+(22,'p','role::user','/v1.MiniBlog/ExportPosts','CALL','deny','',''),
+(23,'p','role::user','/v1.MiniBlog/ImportPosts','CALL','deny','',''),
+(24,'p','role::user','/v1/admin/*','GET','deny','',''),
+(25,'p','role::user','/v1/admin/*','POST','deny','',''),
+(26,'p','role::user','/v1.MiniBlog/ListModeratedPosts','CALL','deny','',''),
+(27,'p','role::user','/v1.MiniBlog/ApproveModeratedPost','CALL','deny','',''),
+(28,'p','role::user','/v1.MiniBlog/RejectModeratedPost','CALL','deny','','');
 /*!40000 ALTER TABLE `casbin_rule` ENABLE KEYS */;
 UNLOCK TABLES;
 
+--
+-- Table structure for table `comment`
+--
+
+DROP TABLE IF EXISTS `comment`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `comment` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `commentID` varchar(38) NOT NULL DEFAULT '' COMMENT '评论唯一 ID',
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '评论者用户唯一 ID',
+  `parentID` varchar(38) NOT NULL DEFAULT '' COMMENT '被回复评论的唯一 ID，顶层评论为空',
+  `content` text NOT NULL COMMENT '评论内容',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '评论创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '评论最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `comment.commentID` (`commentID`),
+  KEY `idx.comment.postID` (`postID`),
+  KEY `idx.comment.parentID` (`parentID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='评论表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `comment`
+--
+
+LOCK TABLES `comment` WRITE;
+/*!40000 ALTER TABLE `comment` DISABLE KEYS */;
+/*!40000 ALTER TABLE `comment` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `follow`
+--
+
+DROP TABLE IF EXISTS `follow`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `follow` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `followerID` varchar(36) NOT NULL DEFAULT '' COMMENT '关注者用户唯一 ID',
+  `followeeID` varchar(36) NOT NULL DEFAULT '' COMMENT '被关注用户唯一 ID',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '关注时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `follow.followerID_followeeID` (`followerID`,`followeeID`),
+  KEY `idx.follow.followeeID` (`followeeID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='用户关注关系表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `follow`
+--
+
+LOCK TABLES `follow` WRITE;
+/*!40000 ALTER TABLE `follow` DISABLE KEYS */;
+/*!40000 ALTER TABLE `follow` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `media`
+--
+
+DROP TABLE IF EXISTS `media`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `media` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `mediaID` varchar(36) NOT NULL DEFAULT '' COMMENT '媒体文件唯一 ID',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '上传者用户唯一 ID',
+  `filename` varchar(255) NOT NULL DEFAULT '' COMMENT '上传时的文件名',
+  `contentType` varchar(64) NOT NULL DEFAULT '' COMMENT '文件MIME类型',
+  `size` bigint(20) NOT NULL DEFAULT 0 COMMENT '文件大小，单位字节',
+  `hash` char(64) NOT NULL DEFAULT '' COMMENT '文件内容的SHA-256摘要',
+  `objectKey` varchar(128) NOT NULL DEFAULT '' COMMENT '文件在对象存储中的key，内容相同的文件共用同一个对象',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '文件上传时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `media.mediaID` (`mediaID`),
+  UNIQUE KEY `media.userID_hash` (`userID`,`hash`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='媒体文件表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `media`
+--
+
+LOCK TABLES `media` WRITE;
+/*!40000 ALTER TABLE `media` DISABLE KEYS */;
+/*!40000 ALTER TABLE `media` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `notification`
+--
+
+DROP TABLE IF EXISTS `notification`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `notification` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `notificationID` varchar(43) NOT NULL DEFAULT '' COMMENT '通知唯一 ID',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '接收通知的用户唯一 ID',
+  `actorID` varchar(36) NOT NULL DEFAULT '' COMMENT '触发通知的用户唯一 ID',
+  `type` tinyint(4) unsigned NOT NULL DEFAULT 0 COMMENT '通知类型：0-博文被点赞，1-博文收到评论，2-评论收到回复，3-被关注',
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '相关博文唯一 ID',
+  `commentID` varchar(38) NOT NULL DEFAULT '' COMMENT '相关评论唯一 ID',
+  `readAt` datetime DEFAULT NULL COMMENT '已读时间，未读时为空',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '通知创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `notification.notificationID` (`notificationID`),
+  KEY `idx.notification.userID_readAt` (`userID`,`readAt`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='站内通知表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `notification`
+--
+
+LOCK TABLES `notification` WRITE;
+/*!40000 ALTER TABLE `notification` DISABLE KEYS */;
+/*!40000 ALTER TABLE `notification` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `post`
 --
 
 DROP TABLE IF EXISTS `post`;
+-- ngram 分词器会丢弃包含停用词的分词（如 in、on），创建全文索引前关闭停用词
+SET SESSION innodb_ft_enable_stopword = OFF;
 /*!40101 SET @saved_cs_client     = @@character_set_client */;
 /*!40101 SET character_set_client = utf8 */;
 CREATE TABLE `post` (
@@ -71,12 +232,28 @@ CREATE TABLE `post` (
   `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '用户唯一 ID',
   `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
   `title` varchar(256) NOT NULL DEFAULT '' COMMENT '博文标题',
+  `slug` varchar(64) NOT NULL DEFAULT '' COMMENT '博文当前的slug',
   `content` longtext NOT NULL DEFAULT '' COMMENT '博文内容',
+  `status` tinyint(4) NOT NULL DEFAULT 0 COMMENT '博文状态：0-草稿，1-已发布，2-已归档，3-等待审核，4-未通过审核',
+  `format` tinyint(4) NOT NULL DEFAULT 0 COMMENT '博文内容格式：0-Markdown，1-纯文本，2-HTML',
+  `publishedAt` datetime DEFAULT NULL COMMENT '博文首次发布时间',
+  `publishAt` datetime DEFAULT NULL COMMENT '定时发布时间',
   `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '博文创建时间',
   `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '博文最后修改时间',
+  `viewCount` bigint(20) NOT NULL DEFAULT 0 COMMENT '浏览次数',
+  `version` bigint(20) NOT NULL DEFAULT 1 COMMENT '博文版本号，每次修改加1',
+  `heldStatus` tinyint(4) NOT NULL DEFAULT 0 COMMENT '进入审核前的博文状态，审核通过后恢复',
+  `moderationReason` varchar(256) NOT NULL DEFAULT '' COMMENT '博文进入审核或未通过审核的原因',
+  `externalID` varchar(64) DEFAULT NULL COMMENT '导入博文在来源系统中的唯一 ID',
+  `deletedAt` datetime DEFAULT NULL COMMENT '博文删除时间，为空表示未删除',
   PRIMARY KEY (`id`),
   UNIQUE KEY `post.postID` (`postID`),
-  KEY `idx.post.userID` (`userID`)
+  UNIQUE KEY `post.userID_externalID` (`userID`,`externalID`),
+  KEY `idx.post.userID` (`userID`),
+  KEY `idx.post.status` (`status`),
+  KEY `idx.post.publishAt` (`publishAt`),
+  KEY `idx.post.deletedAt` (`deletedAt`),
+  FULLTEXT KEY `idx.post.title_content` (`title`,`content`) WITH PARSER ngram
 ) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文表';
 /*!40101 SET character_set_client = @saved_cs_client */;
 
@@ -89,6 +266,209 @@ LOCK TABLES `post` WRITE;
 /*!40000 ALTER TABLE `post` ENABLE KEYS */;
 UNLOCK TABLES;
 
+--
+-- Table structure for table `post_like`
+--
+
+DROP TABLE IF EXISTS `post_like`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_like` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '点赞用户唯一 ID',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '点赞时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `post_like.postID_userID` (`postID`,`userID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文点赞表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_like`
+--
+
+LOCK TABLES `post_like` WRITE;
+/*!40000 ALTER TABLE `post_like` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_like` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `post_pin`
+--
+
+DROP TABLE IF EXISTS `post_pin`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_pin` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '作者用户唯一 ID',
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `position` int(11) NOT NULL DEFAULT 0 COMMENT '置顶位置，从1开始',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '置顶时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `post_pin.postID` (`postID`),
+  KEY `idx.post_pin.userID_position` (`userID`,`position`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文置顶表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_pin`
+--
+
+LOCK TABLES `post_pin` WRITE;
+/*!40000 ALTER TABLE `post_pin` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_pin` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `post_revision`
+--
+
+DROP TABLE IF EXISTS `post_revision`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_revision` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `revision` bigint(20) NOT NULL COMMENT '版本号，同一博文内从 1 开始递增',
+  `title` varchar(256) NOT NULL DEFAULT '' COMMENT '该版本的博文标题',
+  `content` longtext NOT NULL DEFAULT '' COMMENT '该版本的博文内容',
+  `format` tinyint(4) NOT NULL DEFAULT 0 COMMENT '该版本的博文内容格式',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '版本创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `post_revision.postID_revision` (`postID`,`revision`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文历史版本表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_revision`
+--
+
+LOCK TABLES `post_revision` WRITE;
+/*!40000 ALTER TABLE `post_revision` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_revision` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `post_slug`
+--
+
+DROP TABLE IF EXISTS `post_slug`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_slug` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '作者用户唯一 ID',
+  `slug` varchar(64) NOT NULL DEFAULT '' COMMENT '博文slug，同一作者下唯一',
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT 'slug创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `post_slug.userID_slug` (`userID`,`slug`),
+  KEY `idx.post_slug.postID` (`postID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文slug表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_slug`
+--
+
+LOCK TABLES `post_slug` WRITE;
+/*!40000 ALTER TABLE `post_slug` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_slug` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `post_tag`
+--
+
+DROP TABLE IF EXISTS `post_tag`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `post_tag` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `tagID` bigint(20) unsigned NOT NULL COMMENT '标签 ID',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '关联创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `post_tag.postID_tagID` (`postID`,`tagID`),
+  KEY `idx.post_tag.tagID` (`tagID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文标签关联表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `post_tag`
+--
+
+LOCK TABLES `post_tag` WRITE;
+/*!40000 ALTER TABLE `post_tag` DISABLE KEYS */;
+/*!40000 ALTER TABLE `post_tag` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `series`
+--
+
+DROP TABLE IF EXISTS `series`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `series` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `seriesID` varchar(36) NOT NULL DEFAULT '' COMMENT '系列唯一 ID',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '作者用户唯一 ID',
+  `title` varchar(256) NOT NULL DEFAULT '' COMMENT '系列标题',
+  `description` varchar(1024) NOT NULL DEFAULT '' COMMENT '系列简介',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '系列创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '系列最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `series.seriesID` (`seriesID`),
+  KEY `idx.series.userID` (`userID`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文系列表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `series_post`
+--
+
+DROP TABLE IF EXISTS `series_post`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `series_post` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `seriesID` varchar(36) NOT NULL DEFAULT '' COMMENT '系列唯一 ID',
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID，一篇博文只能属于一个系列',
+  `position` int(11) NOT NULL DEFAULT 0 COMMENT '博文在系列中的位置，从1开始',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '加入系列的时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `series_post.postID` (`postID`),
+  KEY `idx.series_post.seriesID_position` (`seriesID`,`position`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='系列与博文关联表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Table structure for table `tag`
+--
+
+DROP TABLE IF EXISTS `tag`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `tag` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `name` varchar(32) NOT NULL DEFAULT '' COMMENT '标签名称（唯一）',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '标签创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `tag.name` (`name`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='标签表';
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `tag`
+--
+
+LOCK TABLES `tag` WRITE;
+/*!40000 ALTER TABLE `tag` DISABLE KEYS */;
+/*!40000 ALTER TABLE `tag` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `user`
 --
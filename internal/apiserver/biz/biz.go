@@ -1,8 +1,23 @@
 package biz
 
 import (
+	"time"
+
+	bookmarkv1 "github.com/ArthurWang23/miniblog/internal/apiserver/biz/v1/bookmark"
+	commentv1 "github.com/ArthurWang23/miniblog/internal/apiserver/biz/v1/comment"
+	followv1 "github.com/ArthurWang23/miniblog/internal/apiserver/biz/v1/follow"
+	mediav1 "github.com/ArthurWang23/miniblog/internal/apiserver/biz/v1/media"
+	notificationv1 "github.com/ArthurWang23/miniblog/internal/apiserver/biz/v1/notification"
 	postv1 "github.com/ArthurWang23/miniblog/internal/apiserver/biz/v1/post"
+	seriesv1 "github.com/ArthurWang23/miniblog/internal/apiserver/biz/v1/series"
+	tagv1 "github.com/ArthurWang23/miniblog/internal/apiserver/biz/v1/tag"
 	userv1 "github.com/ArthurWang23/miniblog/internal/apiserver/biz/v1/user"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/blob"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/event"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/moderation"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/render"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/timeline"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/viewcount"
 	"github.com/ArthurWang23/miniblog/internal/apiserver/store"
 	"github.com/ArthurWang23/miniblog/pkg/auth"
 	"github.com/google/wire"
@@ -16,17 +31,50 @@ type IBiz interface {
 	UserV1() userv1.UserBiz
 	// 获取博文业务接口
 	PostV1() postv1.PostBiz
+	// 获取标签业务接口
+	TagV1() tagv1.TagBiz
+	// 获取评论业务接口
+	CommentV1() commentv1.CommentBiz
+	// 获取媒体文件业务接口
+	MediaV1() mediav1.MediaBiz
+	// 获取博文系列业务接口
+	SeriesV1() seriesv1.SeriesBiz
+	// 获取博文收藏业务接口
+	BookmarkV1() bookmarkv1.BookmarkBiz
+	// 获取用户关注业务接口
+	FollowV1() followv1.FollowBiz
+	// 获取站内通知业务接口
+	NotificationV1() notificationv1.NotificationBiz
 }
 
 type biz struct {
-	store store.IStore
-	authz *auth.Authz
+	store    store.IStore
+	authz    *auth.Authz
+	renderer *render.Renderer
+	blobs    blob.Store
+	views    *viewcount.Counter
+	// 上传文件的最大字节数
+	mediaMaxSize int64
+	// 博文内容审核器，为nil时不审核
+	moderator *moderation.Moderator
+	// 关注时间线，目前在读取时查询关注关系
+	timeline timeline.Timeline
+	// 业务模块之间的内部事件，站内通知通过订阅事件生成
+	events event.Publisher
 }
 
+// 博文HTML渲染结果的最大缓存条数
+const (
+	renderCacheSize = 4096
+	// 同一访问者重复浏览同一篇博文时，在该时间内只计一次
+	viewDedupWindow = 30 * time.Minute
+)
+
 var _ IBiz = (*biz)(nil)
 
-func NewBiz(store store.IStore, authz *auth.Authz) *biz {
-	return &biz{store: store, authz: authz}
+func NewBiz(store store.IStore, authz *auth.Authz, blobs blob.Store, mediaMaxSize int64, moderator *moderation.Moderator) *biz {
+	events := event.NewBus(notificationv1.New(store))
+	return &biz{store: store, authz: authz, renderer: render.New(renderCacheSize), blobs: blobs, views: viewcount.New(viewDedupWindow), mediaMaxSize: mediaMaxSize, moderator: moderator, timeline: timeline.NewFanOutOnRead(store), events: events}
 }
 
 func (b *biz) UserV1() userv1.UserBiz {
@@ -34,5 +82,33 @@ func (b *biz) UserV1() userv1.UserBiz {
 }
 
 func (b *biz) PostV1() postv1.PostBiz {
-	return postv1.New(b.store)
+	return postv1.New(b.store, b.renderer, b.views, b.moderator, b.timeline, b.events)
+}
+
+func (b *biz) TagV1() tagv1.TagBiz {
+	return tagv1.New(b.store)
+}
+
+func (b *biz) CommentV1() commentv1.CommentBiz {
+	return commentv1.New(b.store, b.events)
+}
+
+func (b *biz) MediaV1() mediav1.MediaBiz {
+	return mediav1.New(b.store, b.blobs, b.mediaMaxSize)
+}
+
+func (b *biz) SeriesV1() seriesv1.SeriesBiz {
+	return seriesv1.New(b.store)
+}
+
+func (b *biz) BookmarkV1() bookmarkv1.BookmarkBiz {
+	return bookmarkv1.New(b.store)
+}
+
+func (b *biz) FollowV1() followv1.FollowBiz {
+	return followv1.New(b.store, b.events)
+}
+
+func (b *biz) NotificationV1() notificationv1.NotificationBiz {
+	return notificationv1.New(b.store)
 }
@@ -0,0 +1,75 @@
+package bookmark
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store"
+	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+	"gorm.io/gorm"
+)
+
+// 博文收藏，用户可以收藏已发布的博文并附加仅自己可见的备注
+type BookmarkBiz interface {
+	Add(ctx context.Context, rq *apiv1.AddBookmarkRequest) (*apiv1.AddBookmarkResponse, error)
+	Remove(ctx context.Context, rq *apiv1.RemoveBookmarkRequest) (*apiv1.RemoveBookmarkResponse, error)
+	List(ctx context.Context, rq *apiv1.ListBookmarksRequest) (*apiv1.ListBookmarksResponse, error)
+
+	BookmarkExpansion
+}
+
+type BookmarkExpansion interface{}
+
+type bookmarkBiz struct {
+	store store.IStore
+}
+
+var _ BookmarkBiz = (*bookmarkBiz)(nil)
+
+func New(store store.IStore) *bookmarkBiz {
+	return &bookmarkBiz{store: store}
+}
+
+// Add 只能收藏已发布的博文，重复收藏不报错，请求中设置了备注时更新备注
+func (b *bookmarkBiz) Add(ctx context.Context, rq *apiv1.AddBookmarkRequest) (*apiv1.AddBookmarkResponse, error) {
+	_, err := b.store.Post().Get(ctx, where.F("postID", rq.GetPostID(), "status", int32(apiv1.PostStatus_Published)))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrPostNotFound
+		}
+		return nil, err
+	}
+	bookmarkM := &model.BookmarkM{UserID: contextx.UserID(ctx), PostID: rq.GetPostID(), Note: rq.GetNote()}
+	if err := b.store.Bookmark().Upsert(ctx, bookmarkM, rq.Note != nil); err != nil {
+		return nil, err
+	}
+	return &apiv1.AddBookmarkResponse{}, nil
+}
+
+// Remove 取消收藏，未收藏时不报错
+func (b *bookmarkBiz) Remove(ctx context.Context, rq *apiv1.RemoveBookmarkRequest) (*apiv1.RemoveBookmarkResponse, error) {
+	if err := b.store.Bookmark().Delete(ctx, where.T(ctx).F("postID", rq.GetPostID())); err != nil {
+		return nil, err
+	}
+	return &apiv1.RemoveBookmarkResponse{}, nil
+}
+
+// List 收藏和被收藏的博文通过一次关联查询获取，不逐条查询博文
+func (b *bookmarkBiz) List(ctx context.Context, rq *apiv1.ListBookmarksRequest) (*apiv1.ListBookmarksResponse, error) {
+	whr := where.P(int(rq.GetOffset()), int(rq.GetLimit()))
+	count, bookmarkList, err := b.store.Bookmark().ListWithPosts(ctx, contextx.UserID(ctx), int32(apiv1.PostStatus_Published), whr)
+	if err != nil {
+		return nil, err
+	}
+
+	bookmarks := make([]*apiv1.Bookmark, 0, len(bookmarkList))
+	for _, bookmarkM := range bookmarkList {
+		bookmarks = append(bookmarks, conversion.BookmarkModelToBookmarkV1(bookmarkM))
+	}
+	return &apiv1.ListBookmarksResponse{TotalCount: count, Bookmarks: bookmarks}, nil
+}
@@ -0,0 +1,80 @@
+package bookmark
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store/storetest"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+)
+
+func TestBookmarks(t *testing.T) {
+	db, st := storetest.New(t)
+	b := New(st)
+	users := storetest.Users(t, 3)
+	author, reader := users[0], users[1]
+	ctx := storetest.Context(reader)
+
+	now := time.Now()
+	posts := make([]*model.PostM, 0, 3)
+	for range 3 {
+		post := &model.PostM{UserID: author.UserID, Title: "post", Content: "content",
+			Status: int32(apiv1.PostStatus_Published), PublishedAt: &now}
+		require.NoError(t, db.Create(post).Error)
+		posts = append(posts, post)
+	}
+	draft := &model.PostM{UserID: author.UserID, Title: "draft", Content: "content"}
+	require.NoError(t, db.Create(draft).Error)
+
+	_, err := b.Add(ctx, &apiv1.AddBookmarkRequest{PostID: draft.PostID})
+	assert.ErrorIs(t, err, errno.ErrPostNotFound)
+
+	// 重复收藏不报错，未设置备注时保留原备注
+	_, err = b.Add(ctx, &apiv1.AddBookmarkRequest{PostID: posts[0].PostID, Note: ptr.To("read later")})
+	require.NoError(t, err)
+	_, err = b.Add(ctx, &apiv1.AddBookmarkRequest{PostID: posts[0].PostID})
+	require.NoError(t, err)
+	_, err = b.Add(ctx, &apiv1.AddBookmarkRequest{PostID: posts[1].PostID, Note: ptr.To("a")})
+	require.NoError(t, err)
+	_, err = b.Add(ctx, &apiv1.AddBookmarkRequest{PostID: posts[1].PostID, Note: ptr.To("")})
+	require.NoError(t, err)
+	_, err = b.Add(ctx, &apiv1.AddBookmarkRequest{PostID: posts[2].PostID})
+	require.NoError(t, err)
+	// 撤回的博文不出现在收藏列表中
+	require.NoError(t, db.Model(posts[2]).Update("status", int32(apiv1.PostStatus_Draft)).Error)
+
+	list := func() map[string]*apiv1.Bookmark {
+		t.Helper()
+		resp, err := b.List(ctx, &apiv1.ListBookmarksRequest{})
+		require.NoError(t, err)
+		assert.EqualValues(t, len(resp.GetBookmarks()), resp.GetTotalCount())
+		ret := make(map[string]*apiv1.Bookmark, len(resp.GetBookmarks()))
+		for _, bookmark := range resp.GetBookmarks() {
+			ret[bookmark.GetPostID()] = bookmark
+		}
+		return ret
+	}
+	bookmarks := list()
+	require.Len(t, bookmarks, 2)
+	assert.Equal(t, "read later", bookmarks[posts[0].PostID].GetNote())
+	assert.Equal(t, "", bookmarks[posts[1].PostID].GetNote())
+	assert.Equal(t, "post", bookmarks[posts[0].PostID].GetPost().GetTitle())
+	assert.Equal(t, author.UserID, bookmarks[posts[0].PostID].GetPost().GetUserID())
+
+	// 收藏只对自己可见
+	others, err := b.List(storetest.Context(users[2]), &apiv1.ListBookmarksRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, others.GetBookmarks())
+
+	for range 2 {
+		_, err = b.Remove(ctx, &apiv1.RemoveBookmarkRequest{PostID: posts[0].PostID})
+		require.NoError(t, err)
+	}
+	assert.Len(t, list(), 1)
+}
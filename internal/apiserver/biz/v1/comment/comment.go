@@ -0,0 +1,188 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/event"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store"
+	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	"github.com/ArthurWang23/miniblog/internal/pkg/known"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+	"gorm.io/gorm"
+)
+
+type CommentBiz interface {
+	Create(ctx context.Context, rq *apiv1.CreateCommentRequest) (*apiv1.CreateCommentResponse, error)
+	Delete(ctx context.Context, rq *apiv1.DeleteCommentRequest) (*apiv1.DeleteCommentResponse, error)
+	List(ctx context.Context, rq *apiv1.ListCommentsRequest) (*apiv1.ListCommentsResponse, error)
+
+	CommentExpansion
+}
+
+type CommentExpansion interface{}
+
+type commentBiz struct {
+	store  store.IStore
+	events event.Publisher
+}
+
+var _ CommentBiz = (*commentBiz)(nil)
+
+func New(store store.IStore, events event.Publisher) *commentBiz {
+	return &commentBiz{store: store, events: events}
+}
+
+// Create 只允许评论已发布的博文，回复的评论必须属于同一篇博文
+func (b *commentBiz) Create(ctx context.Context, rq *apiv1.CreateCommentRequest) (*apiv1.CreateCommentResponse, error) {
+	postM, err := b.getPublishedPost(ctx, rq.GetPostID())
+	if err != nil {
+		return nil, err
+	}
+	var parentM *model.CommentM
+	if rq.GetParentID() != "" {
+		parentM, err = b.store.Comment().Get(ctx, where.F("commentID", rq.GetParentID(), "postID", rq.GetPostID()))
+		if err != nil {
+			return nil, commentError(err)
+		}
+	}
+
+	commentM := model.CommentM{
+		PostID:   rq.GetPostID(),
+		UserID:   contextx.UserID(ctx),
+		ParentID: rq.GetParentID(),
+		Content:  rq.GetContent(),
+	}
+	if err := b.store.Comment().Create(ctx, &commentM); err != nil {
+		return nil, err
+	}
+
+	// 被回复评论的作者就是博文作者时只通知一次
+	events := []event.Event{{Type: event.PostCommented, ActorID: commentM.UserID, OwnerID: postM.UserID, PostID: postM.PostID, CommentID: commentM.CommentID}}
+	if parentM != nil && parentM.UserID != postM.UserID {
+		events = append(events, event.Event{Type: event.CommentReplied, ActorID: commentM.UserID, OwnerID: parentM.UserID, PostID: postM.PostID, CommentID: commentM.CommentID})
+	}
+	b.events.Publish(ctx, events...)
+	return &apiv1.CreateCommentResponse{CommentID: commentM.CommentID}, nil
+}
+
+// Delete 评论者可以删除自己的评论，博文作者可以删除自己博文下的任意评论
+// 删除评论时会一并删除它的所有回复
+func (b *commentBiz) Delete(ctx context.Context, rq *apiv1.DeleteCommentRequest) (*apiv1.DeleteCommentResponse, error) {
+	commentM, err := b.store.Comment().Get(ctx, where.F("commentID", rq.GetCommentID()))
+	if err != nil {
+		return nil, commentError(err)
+	}
+
+	userID := contextx.UserID(ctx)
+	if commentM.UserID != userID {
+		postM, err := b.store.Post().Get(ctx, where.F("postID", commentM.PostID))
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		if postM == nil || postM.UserID != userID {
+			return nil, errno.ErrPermissionDenied.WithMessage("only the commenter or the post author can delete this comment")
+		}
+	}
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		commentIDs := []string{commentM.CommentID}
+		for parentIDs := commentIDs; len(parentIDs) > 0; {
+			_, replies, err := b.store.Comment().List(ctx, where.F("parentID", parentIDs))
+			if err != nil {
+				return err
+			}
+			parentIDs = make([]string, 0, len(replies))
+			for _, reply := range replies {
+				parentIDs = append(parentIDs, reply.CommentID)
+			}
+			commentIDs = append(commentIDs, parentIDs...)
+		}
+		return b.store.Comment().Delete(ctx, where.F("commentID", commentIDs))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.DeleteCommentResponse{}, nil
+}
+
+// List 公开接口，匿名用户也可以浏览已发布博文的评论
+func (b *commentBiz) List(ctx context.Context, rq *apiv1.ListCommentsRequest) (*apiv1.ListCommentsResponse, error) {
+	if _, err := b.getPublishedPost(ctx, rq.GetPostID()); err != nil {
+		return nil, err
+	}
+
+	whr := where.F("postID", rq.GetPostID()).P(int(rq.GetOffset()), int(publicLimit(rq.GetLimit())))
+	if rq.ParentID != nil {
+		whr.F("parentID", rq.GetParentID())
+	}
+	count, commentList, err := b.store.Comment().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]*apiv1.Comment, 0, len(commentList))
+	for _, comment := range commentList {
+		comments = append(comments, conversion.CommentModelToCommentV1(comment))
+	}
+	if err := b.withAuthors(ctx, comments...); err != nil {
+		return nil, err
+	}
+	return &apiv1.ListCommentsResponse{TotalCount: count, Comments: comments}, nil
+}
+
+func (b *commentBiz) getPublishedPost(ctx context.Context, postID string) (*model.PostM, error) {
+	postM, err := b.store.Post().Get(ctx, where.F("postID", postID, "status", int32(apiv1.PostStatus_Published)))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrPostNotFound
+		}
+		return nil, err
+	}
+	return postM, nil
+}
+
+// withAuthors 一次性查询所有评论者的信息并填充到评论中
+func (b *commentBiz) withAuthors(ctx context.Context, comments ...*apiv1.Comment) error {
+	if len(comments) == 0 {
+		return nil
+	}
+	userIDs := make([]string, 0, len(comments))
+	for _, comment := range comments {
+		userIDs = append(userIDs, comment.UserID)
+	}
+	slices.Sort(userIDs)
+	_, userList, err := b.store.User().List(ctx, where.F("userID", slices.Compact(userIDs)))
+	if err != nil {
+		return err
+	}
+
+	authors := make(map[string]*apiv1.PostAuthor, len(userList))
+	for _, user := range userList {
+		authors[user.UserID] = conversion.UserModelToPostAuthorV1(user)
+	}
+	for _, comment := range comments {
+		comment.Author = authors[comment.UserID]
+	}
+	return nil
+}
+
+func commentError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errno.ErrCommentNotFound
+	}
+	return err
+}
+
+// publicLimit 不经过参数校验直接调用biz层时，同样限制公开接口每页返回的条数
+func publicLimit(limit int64) int64 {
+	if limit <= 0 {
+		return known.DefaultPublicPageSize
+	}
+	return min(limit, known.MaxPublicPageSize)
+}
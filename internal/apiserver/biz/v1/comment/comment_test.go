@@ -0,0 +1,106 @@
+package comment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/event"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store/storetest"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	"github.com/ArthurWang23/miniblog/internal/pkg/known"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+)
+
+// createPost 为user创建一篇博文，published为false时创建草稿
+func createPost(t *testing.T, user *model.UserM, published bool) string {
+	t.Helper()
+	db, _ := storetest.New(t)
+	post := &model.PostM{UserID: user.UserID, Title: "post", Content: "content"}
+	if published {
+		now := time.Now()
+		post.Status, post.PublishedAt = int32(apiv1.PostStatus_Published), &now
+	}
+	require.NoError(t, db.Create(post).Error)
+	return post.PostID
+}
+
+func comment(t *testing.T, b *commentBiz, user *model.UserM, postID, parentID string) string {
+	t.Helper()
+	resp, err := b.Create(storetest.Context(user), &apiv1.CreateCommentRequest{PostID: postID, ParentID: parentID, Content: "comment"})
+	require.NoError(t, err)
+	return resp.GetCommentID()
+}
+
+func TestCreateAndList(t *testing.T) {
+	_, st := storetest.New(t)
+	b := New(st, event.NewBus())
+	users := storetest.Users(t, 2)
+	author, reader := users[0], users[1]
+	postID := createPost(t, author, true)
+
+	// 草稿不能评论，回复的评论必须属于同一篇博文
+	_, err := b.Create(storetest.Context(reader), &apiv1.CreateCommentRequest{PostID: createPost(t, author, false), Content: "c"})
+	assert.ErrorIs(t, err, errno.ErrPostNotFound)
+	otherComment := comment(t, b, reader, createPost(t, author, true), "")
+	_, err = b.Create(storetest.Context(reader), &apiv1.CreateCommentRequest{PostID: postID, ParentID: otherComment, Content: "c"})
+	assert.ErrorIs(t, err, errno.ErrCommentNotFound)
+
+	root := comment(t, b, reader, postID, "")
+	reply := comment(t, b, author, postID, root)
+
+	resp, err := b.List(context.Background(), &apiv1.ListCommentsRequest{PostID: postID})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, resp.GetTotalCount())
+	replies, err := b.List(context.Background(), &apiv1.ListCommentsRequest{PostID: postID, ParentID: &root})
+	require.NoError(t, err)
+	require.Len(t, replies.GetComments(), 1)
+	assert.Equal(t, reply, replies.GetComments()[0].GetCommentID())
+	assert.Equal(t, author.Username, replies.GetComments()[0].GetAuthor().GetUsername())
+}
+
+func TestDeletePermissionsAndReplies(t *testing.T) {
+	_, st := storetest.New(t)
+	b := New(st, event.NewBus())
+	users := storetest.Users(t, 3)
+	author, commenter, stranger := users[0], users[1], users[2]
+	postID := createPost(t, author, true)
+
+	root := comment(t, b, commenter, postID, "")
+	reply := comment(t, b, stranger, postID, root)
+	comment(t, b, commenter, postID, reply)
+	own := comment(t, b, stranger, postID, "")
+
+	// 其他用户不能删除评论
+	_, err := b.Delete(storetest.Context(stranger), &apiv1.DeleteCommentRequest{CommentID: root})
+	assert.ErrorIs(t, err, errno.ErrPermissionDenied)
+
+	// 博文作者可以删除任意评论，所有回复一并删除
+	_, err = b.Delete(storetest.Context(author), &apiv1.DeleteCommentRequest{CommentID: root})
+	require.NoError(t, err)
+	resp, err := b.List(context.Background(), &apiv1.ListCommentsRequest{PostID: postID})
+	require.NoError(t, err)
+	require.Len(t, resp.GetComments(), 1)
+	assert.Equal(t, own, resp.GetComments()[0].GetCommentID())
+
+	// 评论者可以删除自己的评论
+	_, err = b.Delete(storetest.Context(stranger), &apiv1.DeleteCommentRequest{CommentID: own})
+	require.NoError(t, err)
+	_, err = b.Delete(storetest.Context(stranger), &apiv1.DeleteCommentRequest{CommentID: own})
+	assert.ErrorIs(t, err, errno.ErrCommentNotFound)
+}
+
+func TestPublicLimit(t *testing.T) {
+	for limit, want := range map[int64]int64{
+		-1:                          known.DefaultPublicPageSize,
+		0:                           known.DefaultPublicPageSize,
+		10:                          10,
+		known.MaxPublicPageSize + 1: known.MaxPublicPageSize,
+	} {
+		assert.Equal(t, want, publicLimit(limit), "limit=%d", limit)
+	}
+}
@@ -0,0 +1,97 @@
+package follow
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/event"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store"
+	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+	"gorm.io/gorm"
+)
+
+// 用户关注关系，关注列表和粉丝列表对所有登录用户可见
+type FollowBiz interface {
+	Follow(ctx context.Context, rq *apiv1.FollowRequest) (*apiv1.FollowResponse, error)
+	Unfollow(ctx context.Context, rq *apiv1.UnfollowRequest) (*apiv1.UnfollowResponse, error)
+	ListFollowers(ctx context.Context, rq *apiv1.ListFollowersRequest) (*apiv1.ListFollowersResponse, error)
+	ListFollowing(ctx context.Context, rq *apiv1.ListFollowingRequest) (*apiv1.ListFollowingResponse, error)
+
+	FollowExpansion
+}
+
+type FollowExpansion interface{}
+
+type followBiz struct {
+	store  store.IStore
+	events event.Publisher
+}
+
+var _ FollowBiz = (*followBiz)(nil)
+
+func New(store store.IStore, events event.Publisher) *followBiz {
+	return &followBiz{store: store, events: events}
+}
+
+// Follow 不能关注自己，重复关注不报错，也不会重复通知被关注的用户
+func (b *followBiz) Follow(ctx context.Context, rq *apiv1.FollowRequest) (*apiv1.FollowResponse, error) {
+	followerID := contextx.UserID(ctx)
+	if rq.GetUserID() == followerID {
+		return nil, errno.ErrInvalidArgument.WithMessage("cannot follow yourself")
+	}
+	if _, err := b.store.User().Get(ctx, where.F("userID", rq.GetUserID())); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrUserNotFound
+		}
+		return nil, err
+	}
+	followM := &model.FollowM{FollowerID: followerID, FolloweeID: rq.GetUserID()}
+	created, err := b.store.Follow().CreateIfNotExist(ctx, followM)
+	if err != nil {
+		return nil, err
+	}
+	if created {
+		b.events.Publish(ctx, event.Event{Type: event.UserFollowed, ActorID: followerID, OwnerID: rq.GetUserID()})
+	}
+	return &apiv1.FollowResponse{}, nil
+}
+
+// Unfollow 取消关注，未关注时不报错
+func (b *followBiz) Unfollow(ctx context.Context, rq *apiv1.UnfollowRequest) (*apiv1.UnfollowResponse, error) {
+	if err := b.store.Follow().Delete(ctx, where.F("followerID", contextx.UserID(ctx), "followeeID", rq.GetUserID())); err != nil {
+		return nil, err
+	}
+	return &apiv1.UnfollowResponse{}, nil
+}
+
+// ListFollowers 关注关系和关注者的用户信息通过一次关联查询获取
+func (b *followBiz) ListFollowers(ctx context.Context, rq *apiv1.ListFollowersRequest) (*apiv1.ListFollowersResponse, error) {
+	count, followList, err := b.store.Follow().ListFollowers(ctx, rq.GetUserID(), where.P(int(rq.GetOffset()), int(rq.GetLimit())))
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*apiv1.FollowUser, 0, len(followList))
+	for _, followM := range followList {
+		users = append(users, conversion.UserModelToFollowUserV1(followM.Follower, followM))
+	}
+	return &apiv1.ListFollowersResponse{TotalCount: count, Users: users}, nil
+}
+
+func (b *followBiz) ListFollowing(ctx context.Context, rq *apiv1.ListFollowingRequest) (*apiv1.ListFollowingResponse, error) {
+	count, followList, err := b.store.Follow().ListFollowing(ctx, rq.GetUserID(), where.P(int(rq.GetOffset()), int(rq.GetLimit())))
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*apiv1.FollowUser, 0, len(followList))
+	for _, followM := range followList {
+		users = append(users, conversion.UserModelToFollowUserV1(followM.Followee, followM))
+	}
+	return &apiv1.ListFollowingResponse{TotalCount: count, Users: users}, nil
+}
@@ -0,0 +1,62 @@
+package follow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/event"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store/storetest"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+)
+
+func TestFollowGraph(t *testing.T) {
+	_, st := storetest.New(t)
+	b := New(st, event.NewBus())
+	users := storetest.Users(t, 3)
+	ctx := storetest.Context(users[0])
+
+	_, err := b.Follow(ctx, &apiv1.FollowRequest{UserID: users[0].UserID})
+	assert.ErrorIs(t, err, errno.ErrInvalidArgument)
+	_, err = b.Follow(ctx, &apiv1.FollowRequest{UserID: "user-missing"})
+	assert.ErrorIs(t, err, errno.ErrUserNotFound)
+
+	// 重复关注不报错
+	for _, user := range []string{users[1].UserID, users[1].UserID, users[2].UserID} {
+		_, err = b.Follow(ctx, &apiv1.FollowRequest{UserID: user})
+		require.NoError(t, err)
+	}
+	_, err = b.Follow(storetest.Context(users[2]), &apiv1.FollowRequest{UserID: users[1].UserID})
+	require.NoError(t, err)
+
+	following, err := b.ListFollowing(ctx, &apiv1.ListFollowingRequest{UserID: users[0].UserID})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, following.GetTotalCount())
+	assert.ElementsMatch(t, []string{users[1].Username, users[2].Username}, usernames(following.GetUsers()))
+
+	followers, err := b.ListFollowers(ctx, &apiv1.ListFollowersRequest{UserID: users[1].UserID})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, followers.GetTotalCount())
+	assert.ElementsMatch(t, []string{users[0].Username, users[2].Username}, usernames(followers.GetUsers()))
+	for _, user := range followers.GetUsers() {
+		assert.NotNil(t, user.GetFollowedAt())
+	}
+
+	for range 2 {
+		_, err = b.Unfollow(ctx, &apiv1.UnfollowRequest{UserID: users[1].UserID})
+		require.NoError(t, err)
+	}
+	followers, err = b.ListFollowers(ctx, &apiv1.ListFollowersRequest{UserID: users[1].UserID})
+	require.NoError(t, err)
+	assert.Equal(t, []string{users[2].Username}, usernames(followers.GetUsers()))
+}
+
+func usernames(users []*apiv1.FollowUser) []string {
+	names := make([]string, 0, len(users))
+	for _, user := range users {
+		names = append(names, user.GetUsername())
+	}
+	return names
+}
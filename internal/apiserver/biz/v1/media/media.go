@@ -0,0 +1,167 @@
+package media
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/blob"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store"
+	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+	"gorm.io/gorm"
+)
+
+type MediaBiz interface {
+	// Upload 保存r中的文件内容，r由调用方负责关闭
+	Upload(ctx context.Context, info *apiv1.MediaInfo, r io.Reader) (*apiv1.UploadMediaResponse, error)
+
+	MediaExpansion
+}
+
+type MediaExpansion interface{}
+
+type mediaBiz struct {
+	store   store.IStore
+	blobs   blob.Store
+	maxSize int64
+}
+
+var _ MediaBiz = (*mediaBiz)(nil)
+
+// 允许上传的文件类型及对应的扩展名，文件类型根据内容检测，不信任客户端声明的类型
+var allowedTypes = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// 文件名的最大长度
+const maxFilenameLength = 255
+
+func New(store store.IStore, blobs blob.Store, maxSize int64) *mediaBiz {
+	return &mediaBiz{store: store, blobs: blobs, maxSize: maxSize}
+}
+
+// Upload 先将文件内容写入临时文件，同时计算摘要并检查大小和类型
+// 内容相同的文件在对象存储中只保存一份，同一用户重复上传时直接返回已有的文件
+func (b *mediaBiz) Upload(ctx context.Context, info *apiv1.MediaInfo, r io.Reader) (*apiv1.UploadMediaResponse, error) {
+	tmp, err := os.CreateTemp("", "miniblog-media-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, hash), io.LimitReader(r, b.maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if size == 0 {
+		return nil, errno.ErrInvalidArgument.WithMessage("media file cannot be empty")
+	}
+	if size > b.maxSize {
+		return nil, errno.ErrMediaTooLarge.WithMessage("media file cannot be larger than %d bytes", b.maxSize)
+	}
+
+	contentType, err := detectContentType(tmp)
+	if err != nil {
+		return nil, err
+	}
+	ext, ok := allowedTypes[contentType]
+	if !ok {
+		return nil, errno.ErrMediaTypeNotAllowed.WithMessage("media type %s is not allowed", contentType)
+	}
+
+	sum := hex.EncodeToString(hash.Sum(nil))
+	if mediaM, err := b.getByHash(ctx, sum); err != nil || mediaM != nil {
+		return b.response(mediaM, true), err
+	}
+
+	key := sum + ext
+	exists, err := b.blobs.Exists(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		if err := b.blobs.Put(ctx, key, tmp); err != nil {
+			return nil, err
+		}
+	}
+
+	mediaM := &model.MediaM{
+		UserID:      contextx.UserID(ctx),
+		Filename:    cleanFilename(info.GetFilename()),
+		ContentType: contentType,
+		Size:        size,
+		Hash:        sum,
+		ObjectKey:   key,
+	}
+	if err := b.store.Media().Create(ctx, mediaM); err != nil {
+		// 同一用户并发上传相同的文件时，唯一索引保证只有一次写入成功
+		if existing, _ := b.getByHash(ctx, sum); existing != nil {
+			return b.response(existing, true), nil
+		}
+		return nil, err
+	}
+	return b.response(mediaM, false), nil
+}
+
+func (b *mediaBiz) getByHash(ctx context.Context, hash string) (*model.MediaM, error) {
+	mediaM, err := b.store.Media().Get(ctx, where.T(ctx).F("hash", hash))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return mediaM, err
+}
+
+func (b *mediaBiz) response(mediaM *model.MediaM, deduplicated bool) *apiv1.UploadMediaResponse {
+	if mediaM == nil {
+		return nil
+	}
+	media := conversion.MediaModelToMediaV1(mediaM)
+	media.Url = b.blobs.URL(mediaM.ObjectKey)
+	return &apiv1.UploadMediaResponse{Media: media, Deduplicated: deduplicated}
+}
+
+// detectContentType 根据文件开头的内容检测MIME类型
+func detectContentType(f *os.File) (string, error) {
+	head := make([]byte, 512)
+	n, err := f.ReadAt(head, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(head[:n]))
+	if err != nil {
+		return "", err
+	}
+	return contentType, nil
+}
+
+// cleanFilename 去掉客户端文件名中的目录部分
+func cleanFilename(name string) string {
+	name = path.Base(strings.ReplaceAll(name, `\`, "/"))
+	if name == "." || name == "/" {
+		return ""
+	}
+	if runes := []rune(name); len(runes) > maxFilenameLength {
+		name = string(runes[:maxFilenameLength])
+	}
+	return name
+}
@@ -0,0 +1,144 @@
+package notification
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/event"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store"
+	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+)
+
+// 站内通知，由其他业务模块发布的事件生成，用户只能查看自己的通知
+type NotificationBiz interface {
+	List(ctx context.Context, rq *apiv1.ListNotificationsRequest) (*apiv1.ListNotificationsResponse, error)
+	MarkRead(ctx context.Context, rq *apiv1.MarkNotificationsReadRequest) (*apiv1.MarkNotificationsReadResponse, error)
+	GetUnreadCount(ctx context.Context, rq *apiv1.GetUnreadNotificationCountRequest) (*apiv1.GetUnreadNotificationCountResponse, error)
+
+	NotificationExpansion
+}
+
+type NotificationExpansion interface {
+	// Handle 订阅内部事件，将用户之间的互动记录为通知
+	Handle(ctx context.Context, e event.Event) error
+}
+
+// 事件类型与通知类型的对应关系，不在其中的事件不生成通知
+var notificationTypes = map[event.Type]apiv1.NotificationType{
+	event.PostLiked:      apiv1.NotificationType_PostLiked,
+	event.PostCommented:  apiv1.NotificationType_PostCommented,
+	event.CommentReplied: apiv1.NotificationType_CommentReplied,
+	event.UserFollowed:   apiv1.NotificationType_UserFollowed,
+}
+
+type notificationBiz struct {
+	store store.IStore
+}
+
+var _ NotificationBiz = (*notificationBiz)(nil)
+
+func New(store store.IStore) *notificationBiz {
+	return &notificationBiz{store: store}
+}
+
+// Handle 用户对自己的操作不生成通知
+// 点赞、关注可以反复撤销后重新操作，同一用户对同一对象的同类操作只通知一次
+func (b *notificationBiz) Handle(ctx context.Context, e event.Event) error {
+	typ, ok := notificationTypes[e.Type]
+	if !ok || e.OwnerID == "" || e.OwnerID == e.ActorID {
+		return nil
+	}
+	// 评论和回复每次都是新的内容，需要分别通知
+	if e.CommentID == "" {
+		whr := where.F("userID", e.OwnerID, "actorID", e.ActorID, "type", int32(typ), "postID", e.PostID).L(1).NoCount()
+		_, notificationList, err := b.store.Notification().List(ctx, whr)
+		if err != nil {
+			return err
+		}
+		if len(notificationList) > 0 {
+			return nil
+		}
+	}
+	return b.store.Notification().Create(ctx, &model.NotificationM{
+		UserID:    e.OwnerID,
+		ActorID:   e.ActorID,
+		Type:      int32(typ),
+		PostID:    e.PostID,
+		CommentID: e.CommentID,
+		CreatedAt: e.OccurredAt,
+	})
+}
+
+func (b *notificationBiz) List(ctx context.Context, rq *apiv1.ListNotificationsRequest) (*apiv1.ListNotificationsResponse, error) {
+	whr := where.T(ctx).P(int(rq.GetOffset()), int(rq.GetLimit()))
+	if rq.Unread != nil {
+		if rq.GetUnread() {
+			whr.Q("readAt IS NULL")
+		} else {
+			whr.Q("readAt IS NOT NULL")
+		}
+	}
+	count, notificationList, err := b.store.Notification().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make([]*apiv1.Notification, 0, len(notificationList))
+	actorIDs := make([]string, 0, len(notificationList))
+	for _, notificationM := range notificationList {
+		notifications = append(notifications, conversion.NotificationModelToNotificationV1(notificationM))
+		actorIDs = append(actorIDs, notificationM.ActorID)
+	}
+	if err := b.withActors(ctx, notifications, actorIDs); err != nil {
+		return nil, err
+	}
+	return &apiv1.ListNotificationsResponse{TotalCount: count, Notifications: notifications}, nil
+}
+
+// MarkRead 已读的通知和不属于当前用户的通知会被忽略
+func (b *notificationBiz) MarkRead(ctx context.Context, rq *apiv1.MarkNotificationsReadRequest) (*apiv1.MarkNotificationsReadResponse, error) {
+	whr := where.T(ctx)
+	if !rq.GetAll() {
+		whr.F("notificationID", rq.GetNotificationIDs())
+	}
+	count, err := b.store.Notification().MarkRead(ctx, whr, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.MarkNotificationsReadResponse{MarkedCount: count}, nil
+}
+
+func (b *notificationBiz) GetUnreadCount(ctx context.Context, rq *apiv1.GetUnreadNotificationCountRequest) (*apiv1.GetUnreadNotificationCountResponse, error) {
+	count, err := b.store.Notification().CountUnread(ctx, contextx.UserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.GetUnreadNotificationCountResponse{UnreadCount: count}, nil
+}
+
+// withActors 一次性查询所有触发者的用户信息，已删除的用户不填充
+func (b *notificationBiz) withActors(ctx context.Context, notifications []*apiv1.Notification, actorIDs []string) error {
+	if len(actorIDs) == 0 {
+		return nil
+	}
+	ids := slices.Clone(actorIDs)
+	slices.Sort(ids)
+	_, userList, err := b.store.User().List(ctx, where.F("userID", slices.Compact(ids)))
+	if err != nil {
+		return err
+	}
+
+	actors := make(map[string]*apiv1.NotificationActor, len(userList))
+	for _, user := range userList {
+		actors[user.UserID] = conversion.UserModelToNotificationActorV1(user)
+	}
+	for i, notification := range notifications {
+		notification.Actor = actors[actorIDs[i]]
+	}
+	return nil
+}
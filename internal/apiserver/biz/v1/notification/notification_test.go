@@ -0,0 +1,49 @@
+package notification
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/event"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store/storetest"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+)
+
+func TestHandleDeduplicatesRepeatableActions(t *testing.T) {
+	_, st := storetest.New(t)
+	b := New(st)
+	users := storetest.Users(t, 2)
+	owner, actor := users[0], users[1]
+	ctx := storetest.Context(owner)
+
+	publish := func(typ event.Type, postID, commentID string) {
+		e := event.Event{Type: typ, ActorID: actor.UserID, OwnerID: owner.UserID, PostID: postID, CommentID: commentID, OccurredAt: time.Now()}
+		require.NoError(t, b.Handle(ctx, e))
+	}
+	// 点赞 -> 取消点赞 -> 点赞、关注 -> 取消关注 -> 关注都只通知一次
+	publish(event.PostLiked, "post-a", "")
+	publish(event.PostLiked, "post-a", "")
+	publish(event.PostLiked, "post-b", "")
+	publish(event.UserFollowed, "", "")
+	publish(event.UserFollowed, "", "")
+	// 每条评论都通知
+	publish(event.PostCommented, "post-a", "comment-1")
+	publish(event.PostCommented, "post-a", "comment-2")
+	// 对自己的操作不通知
+	require.NoError(t, b.Handle(ctx, event.Event{Type: event.PostLiked, ActorID: owner.UserID, OwnerID: owner.UserID, PostID: "post-c"}))
+
+	resp, err := b.List(ctx, &apiv1.ListNotificationsRequest{})
+	require.NoError(t, err)
+	counts := make(map[apiv1.NotificationType]int)
+	for _, n := range resp.GetNotifications() {
+		counts[n.GetType()]++
+	}
+	assert.Equal(t, map[apiv1.NotificationType]int{
+		apiv1.NotificationType_PostLiked:     2,
+		apiv1.NotificationType_UserFollowed:  1,
+		apiv1.NotificationType_PostCommented: 2,
+	}, counts)
+}
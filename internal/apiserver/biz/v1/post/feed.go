@@ -0,0 +1,48 @@
+package post
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ListFeed 公开接口，按首次发布时间倒序返回最近发布的feedSize篇博文
+func (b *postBiz) ListFeed(ctx context.Context, username string) ([]*apiv1.Post, error) {
+	whr := where.F("status", int32(apiv1.PostStatus_Published)).
+		S(clause.OrderByColumn{Column: clause.Column{Name: "publishedAt"}, Desc: true}).
+		L(feedSize).
+		NoCount()
+	if username != "" {
+		userM, err := b.store.User().Get(ctx, where.F("username", username))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrUserNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		whr.F("userID", userM.UserID)
+	}
+	_, postList, err := b.store.Post().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make([]*apiv1.Post, 0, len(postList))
+	for _, post := range postList {
+		posts = append(posts, conversion.PostModelToPostV1(post))
+	}
+	if err := b.withAuthors(ctx, posts...); err != nil {
+		return nil, err
+	}
+	if err := b.withTags(ctx, posts...); err != nil {
+		return nil, err
+	}
+	b.withContentHTML(posts...)
+	return posts, nil
+}
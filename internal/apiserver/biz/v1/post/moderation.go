@@ -0,0 +1,129 @@
+package post
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/moderation"
+	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	"github.com/ArthurWang23/miniblog/internal/pkg/known"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 博文内容审核：
+// 审核规则拒绝的博文不会被保存
+// 需要人工审核的博文进入审核中状态，管理员审核通过后恢复为进入审核前的状态，审核不通过时由作者修改后重新审核
+
+// 审核会修改的博文列
+var moderationColumns = []string{"status", "heldStatus", "moderationReason"}
+
+// moderate 根据审核结果修改博文状态，审核规则拒绝时返回错误
+func (b *postBiz) moderate(postM *model.PostM) error {
+	result := b.moderator.Check(postM.Title, postM.Content)
+	current := apiv1.PostStatus(postM.Status)
+	switch result.Verdict {
+	case moderation.Reject:
+		return errno.ErrPostRejectedByModeration.WithMessage("post content is rejected by moderation: %s", result.Reason)
+	case moderation.Hold:
+		// 已在审核中的博文保留最初进入审核前的状态，未通过审核的博文审核通过后作为草稿
+		switch current {
+		case apiv1.PostStatus_Reviewing:
+		case apiv1.PostStatus_Rejected:
+			postM.HeldStatus = int32(apiv1.PostStatus_Draft)
+		default:
+			postM.HeldStatus = postM.Status
+		}
+		postM.Status = int32(apiv1.PostStatus_Reviewing)
+		postM.ModerationReason = result.Reason
+	default:
+		// 修改后不再命中审核规则，无需继续等待审核
+		switch current {
+		case apiv1.PostStatus_Reviewing:
+			postM.Status = postM.HeldStatus
+		case apiv1.PostStatus_Rejected:
+			postM.Status = int32(apiv1.PostStatus_Draft)
+		}
+		postM.HeldStatus = 0
+		postM.ModerationReason = ""
+	}
+	return nil
+}
+
+// ListModerated 返回所有用户等待审核的博文，最早进入审核的排在前面
+func (b *postBiz) ListModerated(ctx context.Context, rq *apiv1.ListModeratedPostsRequest) (*apiv1.ListModeratedPostsResponse, error) {
+	if contextx.Username(ctx) != known.AdminUsername {
+		return nil, errno.ErrPermissionDenied.WithMessage("only the administrator can list moderated posts")
+	}
+	whr := where.F("status", int32(apiv1.PostStatus_Reviewing)).P(int(rq.GetOffset()), int(rq.GetLimit())).
+		S(clause.OrderByColumn{Column: clause.Column{Name: "updatedAt"}})
+	count, postList, err := b.store.Post().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make([]*apiv1.Post, 0, len(postList))
+	for _, post := range postList {
+		posts = append(posts, conversion.PostModelToPostV1(post))
+	}
+	if err := b.withAuthors(ctx, posts...); err != nil {
+		return nil, err
+	}
+	if err := b.withTags(ctx, posts...); err != nil {
+		return nil, err
+	}
+	return &apiv1.ListModeratedPostsResponse{TotalCount: count, Posts: posts}, nil
+}
+
+// ApproveModerated 审核通过，博文恢复为进入审核前的状态
+func (b *postBiz) ApproveModerated(ctx context.Context, rq *apiv1.ApproveModeratedPostRequest) (*apiv1.ApproveModeratedPostResponse, error) {
+	postM, err := b.getModerated(ctx, rq.GetPostID())
+	if err != nil {
+		return nil, err
+	}
+	postM.Status = postM.HeldStatus
+	postM.HeldStatus = 0
+	postM.ModerationReason = ""
+	if err := b.store.Post().UpdateColumns(ctx, postM, moderationColumns...); err != nil {
+		return nil, err
+	}
+	return &apiv1.ApproveModeratedPostResponse{}, nil
+}
+
+// RejectModerated 审核不通过，博文对外不可见，作者修改后重新审核
+func (b *postBiz) RejectModerated(ctx context.Context, rq *apiv1.RejectModeratedPostRequest) (*apiv1.RejectModeratedPostResponse, error) {
+	postM, err := b.getModerated(ctx, rq.GetPostID())
+	if err != nil {
+		return nil, err
+	}
+	postM.Status = int32(apiv1.PostStatus_Rejected)
+	postM.HeldStatus = 0
+	postM.ModerationReason = rq.GetReason()
+	if err := b.store.Post().UpdateColumns(ctx, postM, moderationColumns...); err != nil {
+		return nil, err
+	}
+	return &apiv1.RejectModeratedPostResponse{}, nil
+}
+
+// getModerated 返回等待审核的博文，仅管理员可以调用
+func (b *postBiz) getModerated(ctx context.Context, postID string) (*model.PostM, error) {
+	if contextx.Username(ctx) != known.AdminUsername {
+		return nil, errno.ErrPermissionDenied.WithMessage("only the administrator can moderate posts")
+	}
+	postM, err := b.store.Post().Get(ctx, where.F("postID", postID))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errno.ErrPostNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if current := apiv1.PostStatus(postM.Status); current != apiv1.PostStatus_Reviewing {
+		return nil, errno.ErrPostStatusTransition.WithMessage("only posts under review can be moderated, current status is %s", current)
+	}
+	return postM, nil
+}
@@ -0,0 +1,165 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 置顶博文：
+// 每个用户最多置顶maxPinnedPosts篇博文，置顶的博文按置顶位置排在作者博文列表第一页的最前面
+// 置顶位置从1开始连续编号，每次置顶或取消置顶都在一个事务中重新编号
+
+// Pin 置顶当前用户的博文，博文已置顶时移动到新的位置
+func (b *postBiz) Pin(ctx context.Context, rq *apiv1.PinPostRequest) (*apiv1.PinPostResponse, error) {
+	var position int64
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		pins, err := b.lockPins(ctx)
+		if err != nil {
+			return err
+		}
+		if _, err := b.store.Post().Get(ctx, where.T(ctx).F("postID", rq.GetPostID())); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errno.ErrPostNotFound
+			}
+			return err
+		}
+
+		postIDs := make([]string, 0, len(pins)+1)
+		for _, pin := range pins {
+			if pin.PostID != rq.GetPostID() {
+				postIDs = append(postIDs, pin.PostID)
+			}
+		}
+		if len(postIDs) >= maxPinnedPosts {
+			return errno.ErrTooManyPinnedPosts
+		}
+		index := len(postIDs)
+		if rq.Position != nil && rq.GetPosition() <= int64(len(postIDs)) {
+			index = int(rq.GetPosition()) - 1
+		}
+		position = int64(index + 1)
+		return b.savePins(ctx, pins, slices.Insert(postIDs, index, rq.GetPostID()))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.PinPostResponse{Position: position}, nil
+}
+
+// Unpin 取消置顶，博文未置顶时不报错
+func (b *postBiz) Unpin(ctx context.Context, rq *apiv1.UnpinPostRequest) (*apiv1.UnpinPostResponse, error) {
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		pins, err := b.lockPins(ctx)
+		if err != nil {
+			return err
+		}
+		postIDs := make([]string, 0, len(pins))
+		for _, pin := range pins {
+			if pin.PostID != rq.GetPostID() {
+				postIDs = append(postIDs, pin.PostID)
+			}
+		}
+		if len(postIDs) == len(pins) {
+			return nil
+		}
+		return b.savePins(ctx, pins, postIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.UnpinPostResponse{}, nil
+}
+
+// lockPins 锁定当前用户后返回其置顶的博文，避免并发置顶时超出上限或出现重复的位置
+func (b *postBiz) lockPins(ctx context.Context) ([]*model.PostPinM, error) {
+	userID := contextx.UserID(ctx)
+	whr := where.F("userID", userID).C(clause.Locking{Strength: clause.LockingStrengthUpdate})
+	if _, err := b.store.User().Get(ctx, whr); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrUserNotFound
+		}
+		return nil, err
+	}
+	return b.store.Pin().ListByUser(ctx, userID)
+}
+
+// savePins 按postIDs的顺序重新编号，删除不在postIDs中的置顶记录
+func (b *postBiz) savePins(ctx context.Context, pins []*model.PostPinM, postIDs []string) error {
+	existing := make(map[string]*model.PostPinM, len(pins))
+	for _, pin := range pins {
+		existing[pin.PostID] = pin
+	}
+	for i, postID := range postIDs {
+		position := int64(i + 1)
+		pin, ok := existing[postID]
+		if !ok {
+			pin = &model.PostPinM{UserID: contextx.UserID(ctx), PostID: postID, Position: position}
+			if err := b.store.Pin().Create(ctx, pin); err != nil {
+				return err
+			}
+			continue
+		}
+		delete(existing, postID)
+		if pin.Position != position {
+			pin.Position = position
+			if err := b.store.Pin().Update(ctx, pin); err != nil {
+				return err
+			}
+		}
+	}
+	if len(existing) == 0 {
+		return nil
+	}
+	removed := make([]string, 0, len(existing))
+	for postID := range existing {
+		removed = append(removed, postID)
+	}
+	return b.store.Pin().Delete(ctx, where.T(ctx).F("postID", removed))
+}
+
+// listPinned 返回用户全部置顶博文的postID，以及其中满足whr条件的博文，博文按置顶位置排列
+func (b *postBiz) listPinned(ctx context.Context, userID string, whr *where.Options) ([]string, []*model.PostM, error) {
+	pins, err := b.store.Pin().ListByUser(ctx, userID)
+	if err != nil || len(pins) == 0 {
+		return nil, nil, err
+	}
+	postIDs := make([]string, 0, len(pins))
+	for _, pin := range pins {
+		postIDs = append(postIDs, pin.PostID)
+	}
+	_, postList, err := b.store.Post().List(ctx, whr.F("userID", userID, "postID", postIDs).NoCount())
+	if err != nil {
+		return nil, nil, err
+	}
+	slices.SortFunc(postList, func(a, b *model.PostM) int {
+		return slices.Index(postIDs, a.PostID) - slices.Index(postIDs, b.PostID)
+	})
+	return postIDs, postList, nil
+}
+
+// firstPage 判断是否在查询第一页，where.P将offset作为从1开始的页码
+func firstPage(offset int64, pageToken string) bool {
+	return offset <= 1 && pageToken == ""
+}
+
+// regularWindow 置顶博文计入第一页的limit，返回第page页中其他博文的offset和条数，条数为0表示不分页
+// 置顶博文数不少于limit时，第一页返回全部置顶博文和一篇其他博文，保证可以继续翻页
+func regularWindow(page int64, limit int64, pinned int) (offset int, size int64) {
+	if limit <= 0 {
+		return 0, 0
+	}
+	first := max(limit-int64(pinned), 1)
+	if page <= 1 {
+		return 0, first
+	}
+	return int(first + (page-2)*limit), limit
+}
@@ -0,0 +1,125 @@
+package post
+
+import (
+	"testing"
+
+	"github.com/onexstack/onexstack/pkg/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store/storetest"
+	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+)
+
+func TestRegularWindow(t *testing.T) {
+	tests := []struct {
+		page, limit int64
+		pinned      int
+		offset      int
+		size        int64
+	}{
+		{page: 1, limit: 10, pinned: 0, offset: 0, size: 10},
+		{page: 2, limit: 10, pinned: 0, offset: 10, size: 10},
+		{page: 1, limit: 10, pinned: 3, offset: 0, size: 7},
+		{page: 2, limit: 10, pinned: 3, offset: 7, size: 10},
+		{page: 3, limit: 10, pinned: 3, offset: 17, size: 10},
+		// 置顶博文占满第一页时仍返回一篇其他博文
+		{page: 1, limit: 2, pinned: 3, offset: 0, size: 1},
+		{page: 2, limit: 2, pinned: 3, offset: 1, size: 2},
+		// 不分页
+		{page: 1, limit: 0, pinned: 3, offset: 0, size: 0},
+	}
+	for _, tt := range tests {
+		offset, size := regularWindow(tt.page, tt.limit, tt.pinned)
+		assert.Equal(t, tt.offset, offset, "%+v", tt)
+		assert.Equal(t, tt.size, size, "%+v", tt)
+	}
+}
+
+func TestPinOrderAndLimit(t *testing.T) {
+	b := newTestBiz(t, nil)
+	ctx := storetest.Context(storetest.Users(t, 1)[0])
+	ids := createPosts(t, b, ctx, maxPinnedPosts+1, false)
+
+	pinned := func() []string {
+		pins, err := b.store.Pin().ListByUser(ctx, contextx.UserID(ctx))
+		require.NoError(t, err)
+		ret := make([]string, 0, len(pins))
+		for i, pin := range pins {
+			assert.EqualValues(t, i+1, pin.Position)
+			ret = append(ret, pin.PostID)
+		}
+		return ret
+	}
+
+	for i, id := range ids[:maxPinnedPosts] {
+		resp, err := b.Pin(ctx, &apiv1.PinPostRequest{PostID: id})
+		require.NoError(t, err)
+		assert.EqualValues(t, i+1, resp.GetPosition())
+	}
+	_, err := b.Pin(ctx, &apiv1.PinPostRequest{PostID: ids[maxPinnedPosts]})
+	assert.ErrorIs(t, err, errno.ErrTooManyPinnedPosts)
+	// 已置顶的博文可以移动位置，不受上限限制
+	resp, err := b.Pin(ctx, &apiv1.PinPostRequest{PostID: ids[2], Position: ptr.To[int64](1)})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, resp.GetPosition())
+	assert.Equal(t, []string{ids[2], ids[0], ids[1], ids[3], ids[4]}, pinned())
+
+	_, err = b.Unpin(ctx, &apiv1.UnpinPostRequest{PostID: ids[0]})
+	require.NoError(t, err)
+	assert.Equal(t, []string{ids[2], ids[1], ids[3], ids[4]}, pinned())
+	// 未置顶的博文取消置顶不报错
+	_, err = b.Unpin(ctx, &apiv1.UnpinPostRequest{PostID: ids[0]})
+	require.NoError(t, err)
+}
+
+func TestListCountsPinsTowardLimit(t *testing.T) {
+	b := newTestBiz(t, nil)
+	user := storetest.Users(t, 1)[0]
+	ctx := storetest.Context(user)
+	ids := createPosts(t, b, ctx, 7, true)
+	for _, id := range []string{ids[1], ids[4]} {
+		_, err := b.Pin(ctx, &apiv1.PinPostRequest{PostID: id})
+		require.NoError(t, err)
+	}
+	// 其他博文按创建时间倒序排列
+	want := []string{ids[1], ids[4], ids[6], ids[5], ids[3], ids[2], ids[0]}
+
+	// offset分页
+	var got []string
+	for page := int64(1); page <= 3; page++ {
+		resp, err := b.List(ctx, &apiv1.ListPostRequest{Offset: page, Limit: 3})
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(resp.GetPosts()), 3)
+		assert.EqualValues(t, len(want), resp.GetTotalCount())
+		got = append(got, postIDs(resp.GetPosts())...)
+	}
+	assert.Equal(t, want, got)
+
+	// 游标分页
+	got = nil
+	rq := &apiv1.ListPostRequest{Limit: 3}
+	for {
+		resp, err := b.List(ctx, rq)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(resp.GetPosts()), 3)
+		got = append(got, postIDs(resp.GetPosts())...)
+		if resp.GetNextPageToken() == "" {
+			break
+		}
+		rq.PageToken = resp.GetNextPageToken()
+	}
+	assert.Equal(t, want, got)
+
+	// 公开列表
+	got = nil
+	for page := int64(1); page <= 3; page++ {
+		resp, err := b.ListPublic(ctx, &apiv1.ListPublicPostsRequest{Offset: page, Limit: 3, UserID: &user.UserID})
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(resp.GetPosts()), 3)
+		got = append(got, postIDs(resp.GetPosts())...)
+	}
+	assert.Equal(t, want, got)
+}
@@ -2,14 +2,31 @@ package post
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
 	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/event"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/fieldmask"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/moderation"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/orderby"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/pagetoken"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/render"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/search"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/timeline"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/viewcount"
 	"github.com/ArthurWang23/miniblog/internal/apiserver/store"
 	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	"github.com/ArthurWang23/miniblog/internal/pkg/known"
 	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
 	"github.com/ArthurWang23/miniblog/pkg/store/where"
 	"github.com/jinzhu/copier"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PostBiz interface {
@@ -22,49 +39,233 @@ type PostBiz interface {
 	PostExpansion
 }
 
-type PostExpansion interface{}
+type PostExpansion interface {
+	Publish(ctx context.Context, rq *apiv1.PublishPostRequest) (*apiv1.PublishPostResponse, error)
+	Unpublish(ctx context.Context, rq *apiv1.UnpublishPostRequest) (*apiv1.UnpublishPostResponse, error)
+	Archive(ctx context.Context, rq *apiv1.ArchivePostRequest) (*apiv1.ArchivePostResponse, error)
+	// 公开接口，无需登录即可访问所有用户已发布的博文
+	ListPublic(ctx context.Context, rq *apiv1.ListPublicPostsRequest) (*apiv1.ListPublicPostsResponse, error)
+	GetPublic(ctx context.Context, rq *apiv1.GetPublicPostRequest) (*apiv1.GetPublicPostResponse, error)
+	GetBySlug(ctx context.Context, rq *apiv1.GetPostBySlugRequest) (*apiv1.GetPostBySlugResponse, error)
+	Search(ctx context.Context, rq *apiv1.SearchPostsRequest) (*apiv1.SearchPostsResponse, error)
+	Like(ctx context.Context, rq *apiv1.LikePostRequest) (*apiv1.LikePostResponse, error)
+	Unlike(ctx context.Context, rq *apiv1.UnlikePostRequest) (*apiv1.UnlikePostResponse, error)
+	// 博文历史版本
+	ListRevisions(ctx context.Context, rq *apiv1.ListPostRevisionsRequest) (*apiv1.ListPostRevisionsResponse, error)
+	GetRevision(ctx context.Context, rq *apiv1.GetPostRevisionRequest) (*apiv1.GetPostRevisionResponse, error)
+	RestoreRevision(ctx context.Context, rq *apiv1.RestorePostRevisionRequest) (*apiv1.RestorePostRevisionResponse, error)
+	DiffRevisions(ctx context.Context, rq *apiv1.DiffPostRevisionsRequest) (*apiv1.DiffPostRevisionsResponse, error)
+	// PublishScheduled 由后台任务调用，发布到期的定时博文
+	PublishScheduled(ctx context.Context, now time.Time) (int, error)
+	// 回收站
+	ListDeleted(ctx context.Context, rq *apiv1.ListDeletedPostsRequest) (*apiv1.ListDeletedPostsResponse, error)
+	Restore(ctx context.Context, rq *apiv1.RestorePostRequest) (*apiv1.RestorePostResponse, error)
+	// PurgeDeleted 由后台任务调用，彻底删除在before之前删除的博文
+	PurgeDeleted(ctx context.Context, before time.Time) (int, error)
+	// ListFeed 返回订阅源中的博文，username为空时返回全站的博文
+	ListFeed(ctx context.Context, username string) ([]*apiv1.Post, error)
+	// FlushViews 由后台任务调用，将内存中累计的浏览次数写回数据库，返回更新的博文数
+	FlushViews(ctx context.Context) (int, error)
+	// 批量导入导出，仅管理员可以调用
+	Export(ctx context.Context, rq *apiv1.ExportPostsRequest, send func(*apiv1.ExportPostsResponse) error) error
+	Import(ctx context.Context, format string, author string, next func() ([]byte, error)) (*apiv1.ImportPostsResponse, error)
+	// 置顶博文
+	Pin(ctx context.Context, rq *apiv1.PinPostRequest) (*apiv1.PinPostResponse, error)
+	Unpin(ctx context.Context, rq *apiv1.UnpinPostRequest) (*apiv1.UnpinPostResponse, error)
+	// GetTimeline 返回当前用户关注的人发布的博文
+	GetTimeline(ctx context.Context, rq *apiv1.GetTimelineRequest) (*apiv1.GetTimelineResponse, error)
+	// 内容审核队列，仅管理员可以调用
+	ListModerated(ctx context.Context, rq *apiv1.ListModeratedPostsRequest) (*apiv1.ListModeratedPostsResponse, error)
+	ApproveModerated(ctx context.Context, rq *apiv1.ApproveModeratedPostRequest) (*apiv1.ApproveModeratedPostResponse, error)
+	RejectModerated(ctx context.Context, rq *apiv1.RejectModeratedPostRequest) (*apiv1.RejectModeratedPostResponse, error)
+}
+
+const (
+	// 检索结果中正文摘要的最大字符数
+	snippetSize = 160
+	// 每次最多发布的定时博文数，未发布完的博文在下一轮继续发布
+	scheduledPublishBatch = 100
+	// 每次最多彻底删除的博文数
+	purgeBatch = 100
+	// 订阅源中的博文数
+	feedSize = 20
+	// 导出时每次查询的博文数
+	exportBatch = 100
+	// 导入时每个事务写入的博文数
+	importBatch = 100
+	// 每个用户最多置顶的博文数
+	maxPinnedPosts = 5
+)
+
+// ListPost 允许排序的字段
+var postOrderFields = orderby.Allowlist[model.PostM]{
+	"createdAt": {Column: "createdAt", Value: func(post *model.PostM) any { return post.CreatedAt }},
+	"updatedAt": {Column: "updatedAt", Value: func(post *model.PostM) any { return post.UpdatedAt }},
+	"title":     {Column: "title", Value: func(post *model.PostM) any { return post.Title }},
+}
 
 type postBiz struct {
-	store store.IStore
+	store     store.IStore
+	renderer  *render.Renderer
+	views     *viewcount.Counter
+	moderator *moderation.Moderator
+	timeline  timeline.Timeline
+	events    event.Publisher
 }
 
 var _ PostBiz = (*postBiz)(nil)
 
-func New(store store.IStore) *postBiz {
-	return &postBiz{store: store}
+func New(store store.IStore, renderer *render.Renderer, views *viewcount.Counter, moderator *moderation.Moderator, timeline timeline.Timeline, events event.Publisher) *postBiz {
+	return &postBiz{store: store, renderer: renderer, views: views, moderator: moderator, timeline: timeline, events: events}
 }
 
 func (b *postBiz) Create(ctx context.Context, rq *apiv1.CreatePostRequest) (*apiv1.CreatePostResponse, error) {
 	var postM model.PostM
 	_ = copier.Copy(&postM, rq)
 	postM.UserID = contextx.UserID(ctx)
-	if err := b.store.Post().Create(ctx, &postM); err != nil {
+	// 新建博文默认为草稿，需要显式发布或到达定时发布时间后才对外可见
+	postM.Status = int32(apiv1.PostStatus_Draft)
+	postM.PublishAt = conversion.TimestampToTime(rq.GetPublishAt())
+	if err := b.moderate(&postM); err != nil {
+		return nil, err
+	}
+	// 博文和标签在同一个事务中写入，避免出现只保存了部分标签的博文
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		if _, err := b.assignSlug(ctx, &postM); err != nil {
+			return err
+		}
+		if err := b.store.Post().Create(ctx, &postM); err != nil {
+			return err
+		}
+		if err := b.saveSlug(ctx, &postM); err != nil {
+			return err
+		}
+		return b.setTags(ctx, postM.PostID, rq.GetTags())
+	})
+	if err != nil {
 		return nil, err
 	}
 	return &apiv1.CreatePostResponse{PostID: postM.PostID}, nil
 }
 
+// 允许通过updateMask修改的博文字段
+var postUpdatePaths = []string{"title", "content", "format", "publishAt", "tags"}
+
+// Update 只更新本次修改的列，避免覆盖其他请求并发修改的字段
 func (b *postBiz) Update(ctx context.Context, rq *apiv1.UpdatePostRequest) (*apiv1.UpdatePostResponse, error) {
+	paths, err := updatePostPaths(rq)
+	if err != nil {
+		return nil, err
+	}
 	whr := where.T(ctx).F("postID", rq.GetPostID())
 	postM, err := b.store.Post().Get(ctx, whr)
 	if err != nil {
 		return nil, err
 	}
-	if rq.Title != nil {
+	// 客户端基于旧版本修改时直接返回冲突，避免覆盖其他请求的修改
+	if rq.ExpectedVersion != nil && rq.GetExpectedVersion() != postM.Version {
+		return nil, errno.ErrPostVersionConflict
+	}
+	original := *postM
+	// 只记录值确实发生变化的列，未变化的字段不会写库
+	var columns []string
+	if paths.Has("title") && rq.GetTitle() != postM.Title {
 		postM.Title = rq.GetTitle()
+		// 修改标题时会重新生成slug
+		columns = append(columns, "title", "slug")
 	}
-	if rq.Content != nil {
+	if paths.Has("content") && rq.GetContent() != postM.Content {
 		postM.Content = rq.GetContent()
+		columns = append(columns, "content")
+	}
+	if paths.Has("format") && int32(rq.GetFormat()) != postM.Format {
+		postM.Format = int32(rq.GetFormat())
+		columns = append(columns, "format")
+	}
+	if paths.Has("publishAt") {
+		// 只有草稿可以设置定时发布
+		if current := apiv1.PostStatus(postM.Status); current != apiv1.PostStatus_Draft && rq.PublishAt != nil {
+			return nil, errno.ErrPostStatusTransition.WithMessage("only draft posts can be scheduled, current status is %s", current)
+		}
+		if publishAt := conversion.TimestampToTime(rq.GetPublishAt()); !sameTime(publishAt, postM.PublishAt) {
+			postM.PublishAt = publishAt
+			columns = append(columns, "publishAt")
+		}
+	}
+	tagsChanged := false
+	if paths.Has("tags") {
+		if tagsChanged, err = b.tagsChanged(ctx, postM.PostID, rq.GetTags()); err != nil {
+			return nil, err
+		}
 	}
-	if err := b.store.Post().Update(ctx, postM); err != nil {
+	// 没有任何字段变化时直接返回，不更新版本号和修改时间
+	if len(columns) == 0 && !tagsChanged {
+		return &apiv1.UpdatePostResponse{Version: postM.Version}, nil
+	}
+	// 只有标题或内容变化时才重新审核
+	if postM.Title != original.Title || postM.Content != original.Content {
+		if err := b.moderate(postM); err != nil {
+			return nil, err
+		}
+		columns = append(columns, moderationColumns...)
+	}
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.saveRevision(ctx, &original, postM); err != nil {
+			return err
+		}
+		if err := b.refreshSlug(ctx, &original, postM); err != nil {
+			return err
+		}
+		if err := b.store.Post().UpdateColumns(ctx, postM, columns...); err != nil {
+			return err
+		}
+		if tagsChanged {
+			return b.setTags(ctx, postM.PostID, rq.GetTags())
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	return &apiv1.UpdatePostResponse{}, nil
+	return &apiv1.UpdatePostResponse{Version: postM.Version}, nil
 }
 
+// updatePostPaths 返回本次需要修改的字段，未设置updateMask时由请求中设置了值的字段决定
+func updatePostPaths(rq *apiv1.UpdatePostRequest) (fieldmask.Paths, error) {
+	if rq.UpdateMask != nil {
+		return fieldmask.Parse(rq.GetUpdateMask(), postUpdatePaths...)
+	}
+	paths := fieldmask.Paths{}
+	if rq.Title != nil {
+		paths.Add("title")
+	}
+	if rq.Content != nil {
+		paths.Add("content")
+	}
+	if rq.Format != nil {
+		paths.Add("format")
+	}
+	if rq.PublishAt != nil || rq.GetClearPublishAt() {
+		paths.Add("publishAt")
+	}
+	if rq.GetClearTags() || len(rq.GetTags()) > 0 {
+		paths.Add("tags")
+	}
+	return paths, nil
+}
+
+// Delete 将博文移入回收站，博文的关联数据保留到博文被彻底删除时再清理
+// 请求中其他用户的postID会被忽略
+// 删除时取消置顶，避免回收站中的博文占用置顶名额
 func (b *postBiz) Delete(ctx context.Context, rq *apiv1.DeletePostRequest) (*apiv1.DeletePostResponse, error) {
 	whr := where.T(ctx).F("postID", rq.GetPostIDs())
-	if err := b.store.Post().Delete(ctx, whr); err != nil {
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.Post().Delete(ctx, whr); err != nil {
+			return err
+		}
+		return b.store.Pin().Delete(ctx, where.T(ctx).F("postID", rq.GetPostIDs()))
+	})
+	if err != nil {
 		return nil, err
 	}
 	return &apiv1.DeletePostResponse{}, nil
@@ -76,19 +277,412 @@ func (b *postBiz) Get(ctx context.Context, rq *apiv1.GetPostRequest) (*apiv1.Get
 	if err != nil {
 		return nil, err
 	}
-	return &apiv1.GetPostResponse{Post: conversion.PostModelToPostV1(postM)}, nil
+	post := conversion.PostModelToPostV1(postM)
+	b.recordView(ctx, post.PostID)
+	if err := b.expand(ctx, post); err != nil {
+		return nil, err
+	}
+	if err := b.withSeries(ctx, post, false); err != nil {
+		return nil, err
+	}
+	b.withContentHTML(post)
+	return &apiv1.GetPostResponse{Post: post}, nil
 }
 
+// List 置顶博文按置顶位置排在第一页的最前面并计入limit，其他页不再返回置顶博文
 func (b *postBiz) List(ctx context.Context, rq *apiv1.ListPostRequest) (*apiv1.ListPostResponse, error) {
-	whr := where.T(ctx).P(int(rq.GetOffset()), int(rq.GetLimit()))
+	pinnedIDs, pinnedList, err := b.listPinned(ctx, contextx.UserID(ctx), listPostFilter(ctx, rq))
+	if err != nil {
+		return nil, err
+	}
+	// 按游标翻页时第一页之后不再有置顶博文
+	offset, size := 0, rq.GetLimit()
+	if rq.GetPageToken() == "" {
+		offset, size = regularWindow(rq.GetOffset(), rq.GetLimit(), len(pinnedList))
+	}
+	whr := listPostFilter(ctx, rq).O(offset)
+	if len(pinnedIDs) > 0 {
+		whr.Q("postID NOT IN ?", pinnedIDs)
+	}
+	if rq.GetSkipTotalCount() {
+		whr.NoCount()
+	}
+	orders, err := postOrderFields.Parse(rq.GetOrderBy())
+	if err != nil {
+		return nil, err
+	}
+	if err := pagetoken.Paginate(whr, rq.GetPageToken(), size, orders); err != nil {
+		return nil, err
+	}
 	count, postList, err := b.store.Post().List(ctx, whr)
 	if err != nil {
 		return nil, err
 	}
+	postList, nextPageToken := pagetoken.NextPage(postList, size, func(post *model.PostM) int64 { return post.ID }, orders)
+	if !rq.GetSkipTotalCount() {
+		count += int64(len(pinnedList))
+	}
+	if firstPage(rq.GetOffset(), rq.GetPageToken()) {
+		postList = append(pinnedList, postList...)
+	}
 
+	posts := make([]*apiv1.Post, 0, len(postList))
+	for _, postM := range postList {
+		post := conversion.PostModelToPostV1(postM)
+		post.Pinned = slices.Contains(pinnedIDs, post.PostID)
+		posts = append(posts, post)
+	}
+	if err := b.expand(ctx, posts...); err != nil {
+		return nil, err
+	}
+	return &apiv1.ListPostResponse{TotalCount: count, Posts: posts, NextPageToken: nextPageToken}, nil
+}
+
+// listPostFilter 返回ListPost中除分页以外的查询条件
+func listPostFilter(ctx context.Context, rq *apiv1.ListPostRequest) *where.Options {
+	whr := where.T(ctx)
+	if rq.Status != nil {
+		whr.F("status", int32(rq.GetStatus()))
+	}
+	if tags := normalizeTags(rq.GetTags()); len(tags) > 0 {
+		query, args := store.TaggedWith(tags)
+		whr.Q(query, args...)
+	}
+	if title := strings.TrimSpace(rq.GetTitle()); title != "" {
+		query, args := store.TitleContains(title)
+		whr.Q(query, args...)
+	}
+	whr.R("createdAt", conversion.TimestampToTime(rq.GetCreatedAfter()), conversion.TimestampToTime(rq.GetCreatedBefore()))
+	whr.R("updatedAt", conversion.TimestampToTime(rq.GetUpdatedAfter()), conversion.TimestampToTime(rq.GetUpdatedBefore()))
+	return whr
+}
+
+// 博文状态流转规则：
+// 草稿、归档 -> 发布
+// 发布 -> 草稿
+// 草稿、发布 -> 归档
+func (b *postBiz) Publish(ctx context.Context, rq *apiv1.PublishPostRequest) (*apiv1.PublishPostResponse, error) {
+	err := b.transition(ctx, rq.GetPostID(), apiv1.PostStatus_Published, apiv1.PostStatus_Draft, apiv1.PostStatus_Archived)
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.PublishPostResponse{}, nil
+}
+
+func (b *postBiz) Unpublish(ctx context.Context, rq *apiv1.UnpublishPostRequest) (*apiv1.UnpublishPostResponse, error) {
+	if err := b.transition(ctx, rq.GetPostID(), apiv1.PostStatus_Draft, apiv1.PostStatus_Published); err != nil {
+		return nil, err
+	}
+	return &apiv1.UnpublishPostResponse{}, nil
+}
+
+func (b *postBiz) Archive(ctx context.Context, rq *apiv1.ArchivePostRequest) (*apiv1.ArchivePostResponse, error) {
+	err := b.transition(ctx, rq.GetPostID(), apiv1.PostStatus_Archived, apiv1.PostStatus_Draft, apiv1.PostStatus_Published)
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.ArchivePostResponse{}, nil
+}
+
+// transition 将当前用户的博文从from中的某个状态切换到to状态
+func (b *postBiz) transition(ctx context.Context, postID string, to apiv1.PostStatus, from ...apiv1.PostStatus) error {
+	postM, err := b.store.Post().Get(ctx, where.T(ctx).F("postID", postID))
+	if err != nil {
+		return err
+	}
+	current := apiv1.PostStatus(postM.Status)
+	if !slices.Contains(from, current) {
+		return errno.ErrPostStatusTransition.WithMessage("post cannot transition from %s to %s", current, to)
+	}
+	postM.Status = int32(to)
+	// 只记录首次发布时间，撤回后再次发布不覆盖
+	if to == apiv1.PostStatus_Published && postM.PublishedAt == nil {
+		now := time.Now()
+		postM.PublishedAt = &now
+	}
+	// 手动切换状态后取消定时发布
+	postM.PublishAt = nil
+	return b.store.Post().Update(ctx, postM)
+}
+
+// PublishScheduled 发布定时发布时间不晚于now的草稿，返回本次发布的博文数
+// 多个副本同时执行时，通过 SELECT ... FOR UPDATE SKIP LOCKED 锁定待发布的博文，
+// 其他副本会跳过已被锁定的行，事务提交后博文不再是草稿，从而保证每篇博文只被发布一次
+func (b *postBiz) PublishScheduled(ctx context.Context, now time.Time) (int, error) {
+	var published int
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		whr := where.F("status", int32(apiv1.PostStatus_Draft)).
+			Q("publishAt <= ?", now).
+			L(scheduledPublishBatch).
+			C(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked})
+		_, postList, err := b.store.Post().List(ctx, whr)
+		if err != nil {
+			return err
+		}
+		for _, postM := range postList {
+			postM.Status = int32(apiv1.PostStatus_Published)
+			if postM.PublishedAt == nil {
+				postM.PublishedAt = postM.PublishAt
+			}
+			postM.PublishAt = nil
+			if err := b.store.Post().Update(ctx, postM); err != nil {
+				return err
+			}
+		}
+		published = len(postList)
+		return nil
+	})
+	return published, err
+}
+
+// ListPublic 按作者查询时，作者置顶的博文排在第一页的最前面并计入limit
+func (b *postBiz) ListPublic(ctx context.Context, rq *apiv1.ListPublicPostsRequest) (*apiv1.ListPublicPostsResponse, error) {
+	// 公开接口不使用where.T(ctx)限定租户，只返回已发布的博文
+	whr := where.F("status", int32(apiv1.PostStatus_Published))
+	var pinnedIDs []string
+	var pinnedList []*model.PostM
+	if rq.UserID != nil {
+		whr.F("userID", rq.GetUserID())
+		var err error
+		pinnedIDs, pinnedList, err = b.listPinned(ctx, rq.GetUserID(), where.F("status", int32(apiv1.PostStatus_Published)))
+		if err != nil {
+			return nil, err
+		}
+		if len(pinnedIDs) > 0 {
+			whr.Q("postID NOT IN ?", pinnedIDs)
+		}
+	}
+	offset, size := regularWindow(rq.GetOffset(), publicLimit(rq.GetLimit()), len(pinnedList))
+	whr.O(offset).L(int(size))
+	count, postList, err := b.store.Post().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+	count += int64(len(pinnedList))
+	if firstPage(rq.GetOffset(), "") {
+		postList = append(pinnedList, postList...)
+	}
+
+	posts := make([]*apiv1.Post, 0, len(postList))
+	for _, postM := range postList {
+		post := conversion.PostModelToPostV1(postM)
+		post.Pinned = slices.Contains(pinnedIDs, post.PostID)
+		posts = append(posts, post)
+	}
+	if err := b.withAuthors(ctx, posts...); err != nil {
+		return nil, err
+	}
+	if err := b.expand(ctx, posts...); err != nil {
+		return nil, err
+	}
+	b.withContentHTML(posts...)
+	return &apiv1.ListPublicPostsResponse{TotalCount: count, Posts: posts}, nil
+}
+
+func (b *postBiz) GetPublic(ctx context.Context, rq *apiv1.GetPublicPostRequest) (*apiv1.GetPublicPostResponse, error) {
+	whr := where.F("postID", rq.GetPostID(), "status", int32(apiv1.PostStatus_Published))
+	postM, err := b.store.Post().Get(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+	post := conversion.PostModelToPostV1(postM)
+	b.recordView(ctx, post.PostID)
+	if err := b.withAuthors(ctx, post); err != nil {
+		return nil, err
+	}
+	if err := b.expand(ctx, post); err != nil {
+		return nil, err
+	}
+	if err := b.withSeries(ctx, post, true); err != nil {
+		return nil, err
+	}
+	b.withContentHTML(post)
+	return &apiv1.GetPublicPostResponse{Post: post}, nil
+}
+
+// publicLimit 不经过参数校验直接调用biz层时，同样限制公开接口每页返回的条数
+func publicLimit(limit int64) int64 {
+	if limit <= 0 {
+		return known.DefaultPublicPageSize
+	}
+	return min(limit, known.MaxPublicPageSize)
+}
+
+// withAuthors 一次性查询所有博文的作者信息并填充到博文中，避免逐条查询用户表
+func (b *postBiz) withAuthors(ctx context.Context, posts ...*apiv1.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+	userIDs := make([]string, 0, len(posts))
+	for _, post := range posts {
+		userIDs = append(userIDs, post.UserID)
+	}
+	slices.Sort(userIDs)
+	_, userList, err := b.store.User().List(ctx, where.F("userID", slices.Compact(userIDs)))
+	if err != nil {
+		return err
+	}
+
+	authors := make(map[string]*apiv1.PostAuthor, len(userList))
+	for _, user := range userList {
+		authors[user.UserID] = conversion.UserModelToPostAuthorV1(user)
+	}
+	for _, post := range posts {
+		post.Author = authors[post.UserID]
+	}
+	return nil
+}
+
+// setTags 使用names覆盖博文的标签，调用方需要保证在事务中执行
+func (b *postBiz) setTags(ctx context.Context, postID string, names []string) error {
+	tags, err := b.store.Tag().Ensure(ctx, normalizeTags(names))
+	if err != nil {
+		return err
+	}
+	tagIDs := make([]int64, 0, len(tags))
+	for _, tag := range tags {
+		tagIDs = append(tagIDs, tag.ID)
+	}
+	return b.store.Tag().SetPostTags(ctx, postID, tagIDs)
+}
+
+// tagsChanged 判断names规范化后是否与博文当前的标签不同
+func (b *postBiz) tagsChanged(ctx context.Context, postID string, names []string) (bool, error) {
+	tags, err := b.store.Tag().ListPostTags(ctx, []string{postID})
+	if err != nil {
+		return false, err
+	}
+	current, wanted := slices.Clone(tags[postID]), normalizeTags(names)
+	slices.Sort(current)
+	slices.Sort(wanted)
+	return !slices.Equal(current, wanted), nil
+}
+
+// sameTime 判断两个可能为空的时间是否相同
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// expand 批量填充博文的标签、点赞数等关联数据，查询次数与博文数量无关
+func (b *postBiz) expand(ctx context.Context, posts ...*apiv1.Post) error {
+	if err := b.withTags(ctx, posts...); err != nil {
+		return err
+	}
+	b.withViewCounts(posts...)
+	return b.withLikeCounts(ctx, posts...)
+}
+
+// withTags 一次性查询所有博文的标签并填充到博文中
+func (b *postBiz) withTags(ctx context.Context, posts ...*apiv1.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+	postIDs := make([]string, 0, len(posts))
+	for _, post := range posts {
+		postIDs = append(postIDs, post.PostID)
+	}
+	tags, err := b.store.Tag().ListPostTags(ctx, postIDs)
+	if err != nil {
+		return err
+	}
+	for _, post := range posts {
+		post.Tags = tags[post.PostID]
+	}
+	return nil
+}
+
+// withLikeCounts 一次性统计所有博文的点赞数并填充到博文中
+func (b *postBiz) withLikeCounts(ctx context.Context, posts ...*apiv1.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+	postIDs := make([]string, 0, len(posts))
+	for _, post := range posts {
+		postIDs = append(postIDs, post.PostID)
+	}
+	counts, err := b.store.Like().CountByPostIDs(ctx, postIDs)
+	if err != nil {
+		return err
+	}
+	for _, post := range posts {
+		post.LikeCount = counts[post.PostID]
+	}
+	return nil
+}
+
+// withContentHTML 按博文格式渲染HTML，渲染结果由renderer缓存
+func (b *postBiz) withContentHTML(posts ...*apiv1.Post) {
+	for _, post := range posts {
+		post.ContentHtml = b.renderer.Render(post.PostID, post.Format, post.Content)
+	}
+}
+
+// normalizeTags 去除标签首尾空白并统一转为小写，同时去掉重复标签
+func normalizeTags(names []string) []string {
+	tags := make([]string, 0, len(names))
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name != "" && !slices.Contains(tags, name) {
+			tags = append(tags, name)
+		}
+	}
+	return tags
+}
+
+// Search 在当前用户的博文中检索标题和正文
+func (b *postBiz) Search(ctx context.Context, rq *apiv1.SearchPostsRequest) (*apiv1.SearchPostsResponse, error) {
+	terms := search.Terms(rq.GetQuery())
+	whr := where.T(ctx).P(int(rq.GetOffset()), int(rq.GetLimit()))
+	count, postList, err := b.store.Post().Search(ctx, terms, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*apiv1.SearchPostResult, 0, len(postList))
 	posts := make([]*apiv1.Post, 0, len(postList))
 	for _, post := range postList {
-		posts = append(posts, conversion.PostModelToPostV1(post))
+		result := &apiv1.SearchPostResult{
+			Post:           conversion.PostModelToPostV1(post),
+			TitleHighlight: search.Highlight(post.Title, terms),
+			Snippet:        search.Snippet(post.Content, terms, snippetSize),
+		}
+		results = append(results, result)
+		posts = append(posts, result.Post)
+	}
+	if err := b.expand(ctx, posts...); err != nil {
+		return nil, err
+	}
+	return &apiv1.SearchPostsResponse{TotalCount: count, Results: results}, nil
+}
+
+// Like 只能点赞已发布的博文
+func (b *postBiz) Like(ctx context.Context, rq *apiv1.LikePostRequest) (*apiv1.LikePostResponse, error) {
+	postM, err := b.store.Post().Get(ctx, where.F("postID", rq.GetPostID(), "status", int32(apiv1.PostStatus_Published)))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrPostNotFound
+		}
+		return nil, err
+	}
+	likeM := &model.PostLikeM{PostID: rq.GetPostID(), UserID: contextx.UserID(ctx)}
+	created, err := b.store.Like().CreateIfNotExist(ctx, likeM)
+	if err != nil {
+		return nil, err
+	}
+	// 重复点赞不再通知博文作者
+	if created {
+		b.events.Publish(ctx, event.Event{Type: event.PostLiked, ActorID: likeM.UserID, OwnerID: postM.UserID, PostID: postM.PostID})
+	}
+	return &apiv1.LikePostResponse{}, nil
+}
+
+func (b *postBiz) Unlike(ctx context.Context, rq *apiv1.UnlikePostRequest) (*apiv1.UnlikePostResponse, error) {
+	whr := where.F("postID", rq.GetPostID(), "userID", contextx.UserID(ctx))
+	if err := b.store.Like().Delete(ctx, whr); err != nil {
+		return nil, err
 	}
-	return &apiv1.ListPostResponse{TotalCount: count, Posts: posts}, nil
+	return &apiv1.UnlikePostResponse{}, nil
 }
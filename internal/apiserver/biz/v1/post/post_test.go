@@ -0,0 +1,251 @@
+package post
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/event"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/moderation"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/render"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/timeline"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/viewcount"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store/storetest"
+	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	"github.com/ArthurWang23/miniblog/internal/pkg/known"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+)
+
+func newTestBiz(t *testing.T, moderator *moderation.Moderator) *postBiz {
+	_, st := storetest.New(t)
+	return New(st, render.New(16), viewcount.New(time.Minute), moderator, timeline.NewFanOutOnRead(st), event.NewBus())
+}
+
+// adminContext 返回以管理员身份发起请求的上下文
+func adminContext() context.Context {
+	return contextx.WithUsername(context.Background(), known.AdminUsername)
+}
+
+// createPosts 以ctx中的用户身份依次创建n篇博文，publish为true时同时发布，返回按创建顺序排列的postID
+func createPosts(t *testing.T, b *postBiz, ctx context.Context, n int, publish bool) []string {
+	t.Helper()
+	postIDs := make([]string, 0, n)
+	for i := range n {
+		resp, err := b.Create(ctx, &apiv1.CreatePostRequest{Title: fmt.Sprintf("post %d", i), Content: "content"})
+		require.NoError(t, err)
+		if publish {
+			_, err = b.Publish(ctx, &apiv1.PublishPostRequest{PostID: resp.GetPostID()})
+			require.NoError(t, err)
+		}
+		postIDs = append(postIDs, resp.GetPostID())
+	}
+	return postIDs
+}
+
+func postIDs(posts []*apiv1.Post) []string {
+	ids := make([]string, 0, len(posts))
+	for _, post := range posts {
+		ids = append(ids, post.GetPostID())
+	}
+	return ids
+}
+
+func TestStatusTransitions(t *testing.T) {
+	b := newTestBiz(t, nil)
+	users := storetest.Users(t, 2)
+	ctx := storetest.Context(users[0])
+	postID := createPosts(t, b, ctx, 1, false)[0]
+
+	get := func() *apiv1.Post {
+		t.Helper()
+		resp, err := b.Get(ctx, &apiv1.GetPostRequest{PostID: postID})
+		require.NoError(t, err)
+		return resp.GetPost()
+	}
+	post := get()
+	assert.Equal(t, apiv1.PostStatus_Draft, post.GetStatus())
+	assert.Nil(t, post.GetPublishedAt())
+
+	// 其他用户不能修改博文状态
+	_, err := b.Publish(storetest.Context(users[1]), &apiv1.PublishPostRequest{PostID: postID})
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	_, err = b.Publish(ctx, &apiv1.PublishPostRequest{PostID: postID})
+	require.NoError(t, err)
+	post = get()
+	assert.Equal(t, apiv1.PostStatus_Published, post.GetStatus())
+	require.NotNil(t, post.GetPublishedAt())
+	publishedAt := post.GetPublishedAt().AsTime()
+
+	_, err = b.Publish(ctx, &apiv1.PublishPostRequest{PostID: postID})
+	assert.ErrorIs(t, err, errno.ErrPostStatusTransition)
+
+	// 撤回后再次发布不覆盖首次发布时间
+	_, err = b.Unpublish(ctx, &apiv1.UnpublishPostRequest{PostID: postID})
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.PostStatus_Draft, get().GetStatus())
+	_, err = b.Archive(ctx, &apiv1.ArchivePostRequest{PostID: postID})
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.PostStatus_Archived, get().GetStatus())
+	_, err = b.Unpublish(ctx, &apiv1.UnpublishPostRequest{PostID: postID})
+	assert.ErrorIs(t, err, errno.ErrPostStatusTransition)
+	_, err = b.Publish(ctx, &apiv1.PublishPostRequest{PostID: postID})
+	require.NoError(t, err)
+	post = get()
+	assert.Equal(t, apiv1.PostStatus_Published, post.GetStatus())
+	assert.True(t, publishedAt.Equal(post.GetPublishedAt().AsTime()))
+}
+
+func TestListFiltersByStatus(t *testing.T) {
+	b := newTestBiz(t, nil)
+	ctx := storetest.Context(storetest.Users(t, 1)[0])
+	drafts := createPosts(t, b, ctx, 2, false)
+	published := createPosts(t, b, ctx, 1, true)
+
+	for status, want := range map[apiv1.PostStatus][]string{
+		apiv1.PostStatus_Draft:     drafts,
+		apiv1.PostStatus_Published: published,
+		apiv1.PostStatus_Archived:  {},
+	} {
+		resp, err := b.List(ctx, &apiv1.ListPostRequest{Status: &status})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, want, postIDs(resp.GetPosts()), status.String())
+		assert.EqualValues(t, len(want), resp.GetTotalCount(), status.String())
+	}
+	resp, err := b.List(ctx, &apiv1.ListPostRequest{})
+	require.NoError(t, err)
+	assert.Len(t, resp.GetPosts(), 3)
+}
+
+func TestListPublic(t *testing.T) {
+	b := newTestBiz(t, nil)
+	users := storetest.Users(t, 2)
+	author, other := storetest.Context(users[0]), storetest.Context(users[1])
+	published := createPosts(t, b, author, 2, true)
+	draft := createPosts(t, b, author, 1, false)[0]
+	createPosts(t, b, other, 1, true)
+
+	// 无需登录即可访问，只返回已发布的博文并附带作者信息
+	resp, err := b.ListPublic(context.Background(), &apiv1.ListPublicPostsRequest{UserID: &users[0].UserID})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, published, postIDs(resp.GetPosts()))
+	assert.EqualValues(t, 2, resp.GetTotalCount())
+	for _, post := range resp.GetPosts() {
+		assert.Equal(t, users[0].Username, post.GetAuthor().GetUsername())
+	}
+
+	_, err = b.GetPublic(context.Background(), &apiv1.GetPublicPostRequest{PostID: draft})
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	got, err := b.GetPublic(context.Background(), &apiv1.GetPublicPostRequest{PostID: published[0]})
+	require.NoError(t, err)
+	assert.Equal(t, users[0].Nickname, got.GetPost().GetAuthor().GetNickname())
+}
+
+func TestListPublicCapsPageSize(t *testing.T) {
+	b := newTestBiz(t, nil)
+	db, _ := storetest.New(t)
+	user := storetest.Users(t, 1)[0]
+	now := time.Now()
+	for range known.MaxPublicPageSize + 1 {
+		require.NoError(t, db.Create(&model.PostM{UserID: user.UserID, Title: "post", Content: "content",
+			Status: int32(apiv1.PostStatus_Published), PublishedAt: &now}).Error)
+	}
+
+	// 不经过参数校验直接调用biz层时同样使用默认值和上限
+	for limit, want := range map[int64]int{
+		0:                           known.DefaultPublicPageSize,
+		known.MaxPublicPageSize * 2: known.MaxPublicPageSize,
+		5:                           5,
+	} {
+		resp, err := b.ListPublic(context.Background(), &apiv1.ListPublicPostsRequest{UserID: &user.UserID, Limit: limit})
+		require.NoError(t, err)
+		assert.Len(t, resp.GetPosts(), want, "limit=%d", limit)
+		assert.EqualValues(t, known.MaxPublicPageSize+1, resp.GetTotalCount())
+	}
+}
+
+func TestListFiltersByTags(t *testing.T) {
+	b := newTestBiz(t, nil)
+	ctx := storetest.Context(storetest.Users(t, 1)[0])
+	ids := make(map[string]string)
+	for title, tags := range map[string][]string{
+		"both": {"Go", " db ", "go"},
+		"go":   {"go"},
+		"db":   {"db"},
+		"none": nil,
+	} {
+		resp, err := b.Create(ctx, &apiv1.CreatePostRequest{Title: title, Content: "content", Tags: tags})
+		require.NoError(t, err)
+		ids[title] = resp.GetPostID()
+	}
+
+	got, err := b.Get(ctx, &apiv1.GetPostRequest{PostID: ids["both"]})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"go", "db"}, got.GetPost().GetTags())
+
+	// 指定多个标签时返回同时包含全部标签的博文
+	for _, tt := range []struct {
+		tags []string
+		want []string
+	}{
+		{tags: []string{"go"}, want: []string{ids["both"], ids["go"]}},
+		{tags: []string{"GO", "db"}, want: []string{ids["both"]}},
+		{tags: []string{"rust"}, want: []string{}},
+	} {
+		resp, err := b.List(ctx, &apiv1.ListPostRequest{Tags: tt.tags})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, tt.want, postIDs(resp.GetPosts()), "%v", tt.tags)
+		assert.EqualValues(t, len(tt.want), resp.GetTotalCount(), "%v", tt.tags)
+	}
+}
+
+func TestLikeCounts(t *testing.T) {
+	b := newTestBiz(t, nil)
+	db, _ := storetest.New(t)
+	users := storetest.Users(t, 3)
+	ctx := storetest.Context(users[0])
+	ids := createPosts(t, b, ctx, 2, true)
+	draft := createPosts(t, b, ctx, 1, false)[0]
+
+	like := func(user *model.UserM, postID string) error {
+		_, err := b.Like(storetest.Context(user), &apiv1.LikePostRequest{PostID: postID})
+		return err
+	}
+	// 重复点赞只计一次
+	require.NoError(t, like(users[1], ids[0]))
+	require.NoError(t, like(users[1], ids[0]))
+	require.NoError(t, like(users[2], ids[0]))
+	require.NoError(t, like(users[2], ids[1]))
+	assert.ErrorIs(t, like(users[1], draft), errno.ErrPostNotFound)
+	// 唯一索引保证每个用户对每篇博文只有一条点赞记录
+	assert.Error(t, db.Create(&model.PostLikeM{PostID: ids[0], UserID: users[1].UserID}).Error)
+
+	counts := func() map[string]int64 {
+		t.Helper()
+		resp, err := b.List(ctx, &apiv1.ListPostRequest{})
+		require.NoError(t, err)
+		ret := make(map[string]int64, len(resp.GetPosts()))
+		for _, post := range resp.GetPosts() {
+			ret[post.GetPostID()] = post.GetLikeCount()
+		}
+		return ret
+	}
+	assert.Equal(t, map[string]int64{ids[0]: 2, ids[1]: 1, draft: 0}, counts())
+
+	_, err := b.Unlike(storetest.Context(users[1]), &apiv1.UnlikePostRequest{PostID: ids[0]})
+	require.NoError(t, err)
+	_, err = b.Unlike(storetest.Context(users[1]), &apiv1.UnlikePostRequest{PostID: ids[0]})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{ids[0]: 1, ids[1]: 1, draft: 0}, counts())
+
+	got, err := b.Get(ctx, &apiv1.GetPostRequest{PostID: ids[1]})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, got.GetPost().GetLikeCount())
+}
@@ -0,0 +1,157 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+	"github.com/pmezard/go-difflib/difflib"
+	"gorm.io/gorm"
+)
+
+// 博文每次修改标题、内容或格式前，都会把修改前的内容保存为一个历史版本
+// 历史版本与博文更新在同一个事务中写入，博文当前内容不单独作为版本保存
+
+func (b *postBiz) ListRevisions(ctx context.Context, rq *apiv1.ListPostRevisionsRequest) (*apiv1.ListPostRevisionsResponse, error) {
+	if _, err := b.getOwnPost(ctx, rq.GetPostID()); err != nil {
+		return nil, err
+	}
+
+	whr := where.F("postID", rq.GetPostID()).P(int(rq.GetOffset()), int(rq.GetLimit()))
+	count, revisionList, err := b.store.Revision().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*apiv1.PostRevision, 0, len(revisionList))
+	for _, revision := range revisionList {
+		revisions = append(revisions, conversion.PostRevisionModelToPostRevisionV1(revision))
+	}
+	return &apiv1.ListPostRevisionsResponse{TotalCount: count, Revisions: revisions}, nil
+}
+
+func (b *postBiz) GetRevision(ctx context.Context, rq *apiv1.GetPostRevisionRequest) (*apiv1.GetPostRevisionResponse, error) {
+	if _, err := b.getOwnPost(ctx, rq.GetPostID()); err != nil {
+		return nil, err
+	}
+	revisionM, err := b.getRevision(ctx, rq.GetPostID(), rq.GetRevision())
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.GetPostRevisionResponse{Revision: conversion.PostRevisionModelToPostRevisionV1(revisionM)}, nil
+}
+
+// RestoreRevision 使用历史版本的标题和内容覆盖博文，覆盖前的内容同样会保存为新的历史版本，因此恢复操作可以撤销
+func (b *postBiz) RestoreRevision(ctx context.Context, rq *apiv1.RestorePostRevisionRequest) (*apiv1.RestorePostRevisionResponse, error) {
+	postM, err := b.getOwnPost(ctx, rq.GetPostID())
+	if err != nil {
+		return nil, err
+	}
+	revisionM, err := b.getRevision(ctx, rq.GetPostID(), rq.GetRevision())
+	if err != nil {
+		return nil, err
+	}
+
+	original := *postM
+	postM.Title = revisionM.Title
+	postM.Content = revisionM.Content
+	postM.Format = revisionM.Format
+	if err := b.moderate(postM); err != nil {
+		return nil, err
+	}
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.saveRevision(ctx, &original, postM); err != nil {
+			return err
+		}
+		if err := b.refreshSlug(ctx, &original, postM); err != nil {
+			return err
+		}
+		return b.store.Post().Update(ctx, postM)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.RestorePostRevisionResponse{}, nil
+}
+
+// DiffRevisions 以unified diff格式返回两个版本间的差异，to为0时与博文当前内容比较
+func (b *postBiz) DiffRevisions(ctx context.Context, rq *apiv1.DiffPostRevisionsRequest) (*apiv1.DiffPostRevisionsResponse, error) {
+	postM, err := b.getOwnPost(ctx, rq.GetPostID())
+	if err != nil {
+		return nil, err
+	}
+	from, err := b.getRevision(ctx, rq.GetPostID(), rq.GetFrom())
+	if err != nil {
+		return nil, err
+	}
+	to := &model.PostRevisionM{Title: postM.Title, Content: postM.Content}
+	toName := "current"
+	if rq.GetTo() != 0 {
+		if to, err = b.getRevision(ctx, rq.GetPostID(), rq.GetTo()); err != nil {
+			return nil, err
+		}
+		toName = fmt.Sprintf("revision %d", rq.GetTo())
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(revisionText(from)),
+		B:        difflib.SplitLines(revisionText(to)),
+		FromFile: fmt.Sprintf("revision %d", rq.GetFrom()),
+		ToFile:   toName,
+		Context:  3,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.DiffPostRevisionsResponse{Diff: diff}, nil
+}
+
+// saveRevision 在标题、内容或格式发生变化时将before保存为新的历史版本，需要在事务中调用
+func (b *postBiz) saveRevision(ctx context.Context, before *model.PostM, after *model.PostM) error {
+	if before.Title == after.Title && before.Content == after.Content && before.Format == after.Format {
+		return nil
+	}
+	revision, err := b.store.Revision().NextRevision(ctx, before.PostID)
+	if err != nil {
+		return err
+	}
+	return b.store.Revision().Create(ctx, &model.PostRevisionM{
+		PostID:   before.PostID,
+		Revision: revision,
+		Title:    before.Title,
+		Content:  before.Content,
+		Format:   before.Format,
+	})
+}
+
+func (b *postBiz) getOwnPost(ctx context.Context, postID string) (*model.PostM, error) {
+	postM, err := b.store.Post().Get(ctx, where.T(ctx).F("postID", postID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrPostNotFound
+		}
+		return nil, err
+	}
+	return postM, nil
+}
+
+func (b *postBiz) getRevision(ctx context.Context, postID string, revision int64) (*model.PostRevisionM, error) {
+	revisionM, err := b.store.Revision().Get(ctx, where.F("postID", postID, "revision", revision))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ErrPostRevisionNotFound
+		}
+		return nil, err
+	}
+	return revisionM, nil
+}
+
+// revisionText 将标题和内容拼接为用于比较差异的文本
+func revisionText(revision *model.PostRevisionM) string {
+	return "# " + revision.Title + "\n\n" + revision.Content + "\n"
+}
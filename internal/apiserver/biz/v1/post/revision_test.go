@@ -0,0 +1,68 @@
+package post
+
+import (
+	"testing"
+
+	"github.com/onexstack/onexstack/pkg/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store/storetest"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+)
+
+func TestRevisions(t *testing.T) {
+	b := newTestBiz(t, nil)
+	users := storetest.Users(t, 2)
+	ctx := storetest.Context(users[0])
+
+	created, err := b.Create(ctx, &apiv1.CreatePostRequest{Title: "first", Content: "line a"})
+	require.NoError(t, err)
+	postID := created.GetPostID()
+	update := func(rq *apiv1.UpdatePostRequest) {
+		t.Helper()
+		rq.PostID = postID
+		_, err := b.Update(ctx, rq)
+		require.NoError(t, err)
+	}
+	update(&apiv1.UpdatePostRequest{Content: ptr.To("line b")})
+	update(&apiv1.UpdatePostRequest{Title: ptr.To("second")})
+	// 只修改标签不保存历史版本
+	update(&apiv1.UpdatePostRequest{Tags: []string{"go"}})
+
+	list, err := b.ListRevisions(ctx, &apiv1.ListPostRevisionsRequest{PostID: postID})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, list.GetTotalCount())
+	rev1, err := b.GetRevision(ctx, &apiv1.GetPostRevisionRequest{PostID: postID, Revision: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "first", rev1.GetRevision().GetTitle())
+	assert.Equal(t, "line a", rev1.GetRevision().GetContent())
+	_, err = b.GetRevision(ctx, &apiv1.GetPostRevisionRequest{PostID: postID, Revision: 9})
+	assert.ErrorIs(t, err, errno.ErrPostRevisionNotFound)
+
+	diff, err := b.DiffRevisions(ctx, &apiv1.DiffPostRevisionsRequest{PostID: postID, From: 1})
+	require.NoError(t, err)
+	assert.Contains(t, diff.GetDiff(), "--- revision 1\n+++ current\n")
+	assert.Contains(t, diff.GetDiff(), "\n-# first\n")
+	assert.Contains(t, diff.GetDiff(), "\n-line a\n+line b\n")
+	same, err := b.DiffRevisions(ctx, &apiv1.DiffPostRevisionsRequest{PostID: postID, From: 1, To: 1})
+	require.NoError(t, err)
+	assert.Empty(t, same.GetDiff())
+
+	// 恢复前的内容保存为新的历史版本，恢复操作可以撤销
+	_, err = b.RestoreRevision(ctx, &apiv1.RestorePostRevisionRequest{PostID: postID, Revision: 1})
+	require.NoError(t, err)
+	got, err := b.Get(ctx, &apiv1.GetPostRequest{PostID: postID})
+	require.NoError(t, err)
+	assert.Equal(t, "first", got.GetPost().GetTitle())
+	assert.Equal(t, "line a", got.GetPost().GetContent())
+	rev3, err := b.GetRevision(ctx, &apiv1.GetPostRevisionRequest{PostID: postID, Revision: 3})
+	require.NoError(t, err)
+	assert.Equal(t, "second", rev3.GetRevision().GetTitle())
+	assert.Equal(t, "line b", rev3.GetRevision().GetContent())
+
+	// 只有作者可以查看历史版本
+	_, err = b.ListRevisions(storetest.Context(users[1]), &apiv1.ListPostRevisionsRequest{PostID: postID})
+	assert.ErrorIs(t, err, errno.ErrPostNotFound)
+}
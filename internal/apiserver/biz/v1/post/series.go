@@ -0,0 +1,59 @@
+package post
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/conversion"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+	"gorm.io/gorm"
+)
+
+// withSeries 填充博文所属系列的导航信息，博文不属于任何系列时不填充
+// publishedOnly为true时跳过系列中未发布的博文，用于公开接口
+func (b *postBiz) withSeries(ctx context.Context, post *apiv1.Post, publishedOnly bool) error {
+	seriesPostM, err := b.store.Series().GetPostSeries(ctx, post.PostID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	seriesM, err := b.store.Series().Get(ctx, where.F("seriesID", seriesPostM.SeriesID))
+	if err != nil {
+		return err
+	}
+	postList, err := b.store.Series().ListPosts(ctx, seriesM.SeriesID)
+	if err != nil {
+		return err
+	}
+
+	visible := make([]*model.PostM, 0, len(postList))
+	for _, postM := range postList {
+		if !publishedOnly || postM.Status == int32(apiv1.PostStatus_Published) {
+			visible = append(visible, postM)
+		}
+	}
+	for i, postM := range visible {
+		if postM.PostID != post.PostID {
+			continue
+		}
+		nav := &apiv1.PostSeriesNav{
+			SeriesID: seriesM.SeriesID,
+			Title:    seriesM.Title,
+			Position: int64(i + 1),
+			Total:    int64(len(visible)),
+		}
+		if i > 0 {
+			nav.Previous = conversion.PostModelToPostLinkV1(visible[i-1])
+		}
+		if i < len(visible)-1 {
+			nav.Next = conversion.PostModelToPostLinkV1(visible[i+1])
+		}
+		post.Series = nav
+		break
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/slug"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+	"gorm.io/gorm"
+)
+
+// 博文的slug根据标题生成，在同一作者下唯一
+// 标题修改后会生成新的slug，旧的slug仍保留在post_slug表中，通过旧链接访问时跳转到新链接
+
+// GetBySlug 公开接口，通过作者用户名和slug获取已发布的博文
+func (b *postBiz) GetBySlug(ctx context.Context, rq *apiv1.GetPostBySlugRequest) (*apiv1.GetPostBySlugResponse, error) {
+	userM, err := b.store.User().Get(ctx, where.F("username", rq.GetUsername()))
+	if err != nil {
+		return nil, postError(err)
+	}
+	slugM, err := b.store.Slug().Get(ctx, where.F("userID", userM.UserID, "slug", rq.GetSlug()))
+	if err != nil {
+		return nil, postError(err)
+	}
+	postM, err := b.store.Post().Get(ctx, where.F("postID", slugM.PostID, "status", int32(apiv1.PostStatus_Published)))
+	if err != nil {
+		return nil, postError(err)
+	}
+
+	post := conversion.PostModelToPostV1(postM)
+	post.Author = conversion.UserModelToPostAuthorV1(userM)
+	b.recordView(ctx, post.PostID)
+	if err := b.expand(ctx, post); err != nil {
+		return nil, err
+	}
+	if err := b.withSeries(ctx, post, true); err != nil {
+		return nil, err
+	}
+	b.withContentHTML(post)
+	return &apiv1.GetPostBySlugResponse{Post: post, Moved: postM.Slug != rq.GetSlug()}, nil
+}
+
+// assignSlug 根据博文标题设置博文的slug，需要在事务中调用
+// 候选slug依次为 base、base-2、base-3...，跳过已被作者其他博文占用的slug，博文自己用过的slug可以直接复用
+// 返回的slug如果是首次使用，需要在博文创建后调用saveSlug保存
+func (b *postBiz) assignSlug(ctx context.Context, postM *model.PostM) (isNew bool, err error) {
+	base := slug.Make(postM.Title)
+	whr := where.F("userID", postM.UserID).Q("slug = ? OR slug LIKE ?", base, base+"-%")
+	_, slugList, err := b.store.Slug().List(ctx, whr)
+	if err != nil {
+		return false, err
+	}
+	owners := make(map[string]string, len(slugList))
+	for _, slugM := range slugList {
+		owners[slugM.Slug] = slugM.PostID
+	}
+
+	candidate := base
+	for i := 2; ; i++ {
+		owner, taken := owners[candidate]
+		if !taken || (postM.PostID != "" && owner == postM.PostID) {
+			postM.Slug = candidate
+			return !taken, nil
+		}
+		candidate = fmt.Sprintf("%s-%d", base, i)
+	}
+}
+
+func (b *postBiz) saveSlug(ctx context.Context, postM *model.PostM) error {
+	return b.store.Slug().Create(ctx, &model.PostSlugM{UserID: postM.UserID, Slug: postM.Slug, PostID: postM.PostID})
+}
+
+// refreshSlug 在标题变化后重新生成slug，需要在事务中并且在保存博文之前调用
+func (b *postBiz) refreshSlug(ctx context.Context, before *model.PostM, after *model.PostM) error {
+	if before.Title == after.Title {
+		return nil
+	}
+	isNew, err := b.assignSlug(ctx, after)
+	if err != nil || !isNew {
+		return err
+	}
+	return b.saveSlug(ctx, after)
+}
+
+func postError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errno.ErrPostNotFound
+	}
+	return err
+}
@@ -0,0 +1,29 @@
+package post
+
+import (
+	"context"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+)
+
+// GetTimeline 返回当前用户关注的人发布的博文，时间线的生成方式由b.timeline决定
+func (b *postBiz) GetTimeline(ctx context.Context, rq *apiv1.GetTimelineRequest) (*apiv1.GetTimelineResponse, error) {
+	postList, nextPageToken, err := b.timeline.Read(ctx, contextx.UserID(ctx), rq.GetPageToken(), rq.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make([]*apiv1.Post, 0, len(postList))
+	for _, postM := range postList {
+		posts = append(posts, conversion.PostModelToPostV1(postM))
+	}
+	if err := b.withAuthors(ctx, posts...); err != nil {
+		return nil, err
+	}
+	if err := b.expand(ctx, posts...); err != nil {
+		return nil, err
+	}
+	return &apiv1.GetTimelineResponse{Posts: posts, NextPageToken: nextPageToken}, nil
+}
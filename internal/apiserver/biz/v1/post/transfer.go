@@ -0,0 +1,268 @@
+package post
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/postio"
+	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	"github.com/ArthurWang23/miniblog/internal/pkg/known"
+	"github.com/ArthurWang23/miniblog/internal/pkg/log"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/errorsx"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 博文的批量导入导出，用于从其他系统迁移博文
+// 导入按作者和外部ID去重，同一份数据重复导入时更新已有的博文，不会重复创建
+
+// Export 按创建顺序逐篇导出博文，send返回错误时停止导出
+func (b *postBiz) Export(ctx context.Context, rq *apiv1.ExportPostsRequest, send func(*apiv1.ExportPostsResponse) error) error {
+	if contextx.Username(ctx) != known.AdminUsername {
+		return errno.ErrPermissionDenied.WithMessage("only the administrator can export posts")
+	}
+	format, err := postio.ParseFormat(rq.GetFormat())
+	if err != nil {
+		return errno.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+	var userID string
+	if rq.GetUsername() != "" {
+		userM, err := b.store.User().Get(ctx, where.F("username", rq.GetUsername()))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errno.ErrUserNotFound
+		}
+		if err != nil {
+			return err
+		}
+		userID = userM.UserID
+	}
+
+	var lastID int64
+	for {
+		whr := where.S(clause.OrderByColumn{Column: clause.Column{Name: "id"}}).Q("id > ?", lastID).L(exportBatch).NoCount()
+		if userID != "" {
+			whr.F("userID", userID)
+		}
+		_, postList, err := b.store.Post().List(ctx, whr)
+		if err != nil {
+			return err
+		}
+		if len(postList) == 0 {
+			return nil
+		}
+
+		posts := make([]*apiv1.Post, 0, len(postList))
+		for _, postM := range postList {
+			posts = append(posts, conversion.PostModelToPostV1(postM))
+		}
+		if err := b.withAuthors(ctx, posts...); err != nil {
+			return err
+		}
+		if err := b.withTags(ctx, posts...); err != nil {
+			return err
+		}
+		for i, post := range posts {
+			externalID := post.GetPostID()
+			if postList[i].ExternalID != nil {
+				externalID = *postList[i].ExternalID
+			}
+			data, err := postio.Marshal(postio.FromPost(post, externalID), format)
+			if err != nil {
+				return err
+			}
+			if err := send(&apiv1.ExportPostsResponse{ExternalID: externalID, Data: data}); err != nil {
+				return err
+			}
+		}
+		lastID = postList[len(postList)-1].ID
+	}
+}
+
+// importItem 待写入的一篇博文
+type importItem struct {
+	index  int64
+	record *postio.Record
+}
+
+// Import 导入next依次返回的博文，next返回io.EOF时结束，author是博文未指定作者时使用的作者
+// 博文按importBatch分批写入，每批在一个事务中完成；某一批写入失败时逐篇重试，只有出错的博文计入失败
+func (b *postBiz) Import(ctx context.Context, format string, author string, next func() ([]byte, error)) (*apiv1.ImportPostsResponse, error) {
+	if contextx.Username(ctx) != known.AdminUsername {
+		return nil, errno.ErrPermissionDenied.WithMessage("only the administrator can import posts")
+	}
+	f, err := postio.ParseFormat(format)
+	if err != nil {
+		return nil, errno.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+
+	resp := &apiv1.ImportPostsResponse{}
+	authors := make(map[string]string)
+	batch := make([]importItem, 0, importBatch)
+	var index int64
+	for {
+		data, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		index++
+		record, err := postio.Unmarshal(data, f)
+		if err != nil {
+			addImportError(resp, index, "", err)
+			continue
+		}
+		if record.Author == "" {
+			record.Author = author
+		}
+		if err := record.Validate(); err != nil {
+			addImportError(resp, index, record.ExternalID, err)
+			continue
+		}
+		batch = append(batch, importItem{index: index, record: record})
+		if len(batch) == importBatch {
+			b.importBatch(ctx, authors, batch, resp)
+			batch = batch[:0]
+		}
+	}
+	b.importBatch(ctx, authors, batch, resp)
+	// 逐篇重试会打乱失败记录的顺序
+	slices.SortFunc(resp.Errors, func(a, b *apiv1.ImportPostError) int {
+		return cmp.Compare(a.GetIndex(), b.GetIndex())
+	})
+
+	log.W(ctx).Infow("Imported posts", "created", resp.Created, "updated", resp.Updated, "failed", resp.Failed)
+	return resp, nil
+}
+
+func (b *postBiz) importBatch(ctx context.Context, authors map[string]string, batch []importItem, resp *apiv1.ImportPostsResponse) {
+	if len(batch) == 0 {
+		return
+	}
+	var created, updated int64
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		created, updated = 0, 0
+		for _, item := range batch {
+			isNew, err := b.importPost(ctx, authors, item.record)
+			if err != nil {
+				return err
+			}
+			if isNew {
+				created++
+			} else {
+				updated++
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		resp.Created += created
+		resp.Updated += updated
+		return
+	}
+	if len(batch) == 1 {
+		addImportError(resp, batch[0].index, batch[0].record.ExternalID, err)
+		return
+	}
+	for _, item := range batch {
+		b.importBatch(ctx, authors, []importItem{item}, resp)
+	}
+}
+
+// importPost 创建或更新一篇博文，需要在事务中调用
+func (b *postBiz) importPost(ctx context.Context, authors map[string]string, record *postio.Record) (isNew bool, err error) {
+	userID, ok := authors[record.Author]
+	if !ok {
+		userM, err := b.store.User().Get(ctx, where.F("username", record.Author))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, errno.ErrInvalidArgument.WithMessage("author %s not found", record.Author)
+		}
+		if err != nil {
+			return false, err
+		}
+		userID = userM.UserID
+		authors[record.Author] = userID
+	}
+	// Validate已经检查过格式和状态
+	format, _ := record.PostFormat()
+	status, _ := record.PostStatus()
+
+	// (userID, externalID)唯一索引包括回收站中的博文，查询时也需要包括已删除的博文
+	postM, err := b.store.Post().GetUnscoped(ctx, where.F("userID", userID, "externalID", record.ExternalID))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// 本系统创建的博文导出时使用postID作为外部ID，重新导入时更新原博文
+		postM, err = b.store.Post().GetUnscoped(ctx, where.F("userID", userID, "postID", record.ExternalID).Q("externalID IS NULL"))
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, err
+	}
+	// 不自动恢复作者删除的博文，作者需要先从回收站恢复
+	if postM != nil && postM.DeletedAt.Valid {
+		return false, errno.ErrPostInTrash
+	}
+	if postM == nil {
+		postM = &model.PostM{UserID: userID, ExternalID: &record.ExternalID}
+		if record.CreatedAt != nil {
+			postM.CreatedAt = *record.CreatedAt
+		}
+	}
+	original := *postM
+	postM.Title = record.Title
+	postM.Content = record.Content
+	postM.Format = int32(format)
+	postM.Status = int32(status)
+	if record.PublishedAt != nil {
+		postM.PublishedAt = record.PublishedAt
+	}
+	if status == apiv1.PostStatus_Published && postM.PublishedAt == nil {
+		now := time.Now()
+		postM.PublishedAt = &now
+	}
+	// 导入的博文与作者提交的博文一样需要审核，命中审核规则的博文先进入审核
+	if err := b.moderate(postM); err != nil {
+		return false, err
+	}
+
+	if postM.PostID == "" {
+		if _, err := b.assignSlug(ctx, postM); err != nil {
+			return false, err
+		}
+		if err := b.store.Post().Create(ctx, postM); err != nil {
+			return false, err
+		}
+		if err := b.saveSlug(ctx, postM); err != nil {
+			return false, err
+		}
+		return true, b.setTags(ctx, postM.PostID, record.Tags)
+	}
+
+	if err := b.saveRevision(ctx, &original, postM); err != nil {
+		return false, err
+	}
+	if err := b.refreshSlug(ctx, &original, postM); err != nil {
+		return false, err
+	}
+	if err := b.store.Post().Update(ctx, postM); err != nil {
+		return false, err
+	}
+	return false, b.setTags(ctx, postM.PostID, record.Tags)
+}
+
+func addImportError(resp *apiv1.ImportPostsResponse, index int64, externalID string, err error) {
+	resp.Failed++
+	resp.Errors = append(resp.Errors, &apiv1.ImportPostError{
+		Index:      index,
+		ExternalID: externalID,
+		Message:    errorsx.FromError(err).Message,
+	})
+}
@@ -0,0 +1,63 @@
+package post
+
+import (
+	"io"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/moderation"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store/storetest"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+)
+
+// lines 依次返回records，用作Import的next函数
+func lines(records ...string) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		if len(records) == 0 {
+			return nil, io.EOF
+		}
+		record := records[0]
+		records = records[1:]
+		return []byte(record), nil
+	}
+}
+
+func TestImportModeratesPosts(t *testing.T) {
+	b := newTestBiz(t, moderation.New(
+		moderation.BannedWords([]string{"casino"}, moderation.Reject),
+		moderation.Pattern("phone", regexp.MustCompile(`\d{11}`), moderation.Hold),
+	))
+	db, _ := storetest.New(t)
+	author := storetest.Users(t, 1)[0]
+
+	resp, err := b.Import(adminContext(), "jsonl", author.Username, lines(
+		`{"externalID":"clean","title":"hello","content":"world","status":"published"}`,
+		`{"externalID":"banned","title":"casino","content":"world","status":"published"}`,
+		`{"externalID":"held","title":"call me","content":"13800000000","status":"published"}`,
+	))
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, resp.GetCreated())
+	assert.EqualValues(t, 1, resp.GetFailed())
+	require.Len(t, resp.GetErrors(), 1)
+	assert.Equal(t, "banned", resp.GetErrors()[0].GetExternalID())
+
+	var held model.PostM
+	require.NoError(t, db.Where("userID = ? AND externalID = ?", author.UserID, "held").First(&held).Error)
+	assert.EqualValues(t, apiv1.PostStatus_Reviewing, held.Status)
+	assert.EqualValues(t, apiv1.PostStatus_Published, held.HeldStatus)
+
+	var clean model.PostM
+	require.NoError(t, db.Where("userID = ? AND externalID = ?", author.UserID, "clean").First(&clean).Error)
+	assert.EqualValues(t, apiv1.PostStatus_Published, clean.Status)
+
+	// 重新导入时同样需要审核
+	resp, err = b.Import(adminContext(), "jsonl", author.Username, lines(
+		`{"externalID":"clean","title":"hello","content":"casino","status":"published"}`,
+	))
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, resp.GetFailed())
+}
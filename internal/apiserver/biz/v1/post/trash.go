@@ -0,0 +1,89 @@
+package post
+
+import (
+	"context"
+	"time"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// 删除博文时只做软删除，博文进入回收站，可以在保留期限内恢复
+// 超过保留期限的博文由后台任务连同评论、点赞、历史版本等关联数据一起彻底删除
+
+func (b *postBiz) ListDeleted(ctx context.Context, rq *apiv1.ListDeletedPostsRequest) (*apiv1.ListDeletedPostsResponse, error) {
+	whr := where.T(ctx).P(int(rq.GetOffset()), int(rq.GetLimit()))
+	count, postList, err := b.store.Post().ListDeleted(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make([]*apiv1.Post, 0, len(postList))
+	for _, postM := range postList {
+		post := conversion.PostModelToPostV1(postM)
+		post.DeletedAt = timestamppb.New(postM.DeletedAt.Time)
+		posts = append(posts, post)
+	}
+	if err := b.expand(ctx, posts...); err != nil {
+		return nil, err
+	}
+	return &apiv1.ListDeletedPostsResponse{TotalCount: count, Posts: posts}, nil
+}
+
+func (b *postBiz) Restore(ctx context.Context, rq *apiv1.RestorePostRequest) (*apiv1.RestorePostResponse, error) {
+	restored, err := b.store.Post().Restore(ctx, where.T(ctx).F("postID", rq.GetPostID()))
+	if err != nil {
+		return nil, err
+	}
+	if restored == 0 {
+		return nil, errno.ErrPostNotFound
+	}
+	return &apiv1.RestorePostResponse{}, nil
+}
+
+func (b *postBiz) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	_, postList, err := b.store.Post().ListDeleted(ctx, where.L(purgeBatch).Q("deletedAt < ?", before))
+	if err != nil || len(postList) == 0 {
+		return 0, err
+	}
+	postIDs := make([]string, 0, len(postList))
+	for _, post := range postList {
+		postIDs = append(postIDs, post.PostID)
+	}
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.Post().Purge(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := b.store.Comment().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := b.store.Like().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := b.store.Bookmark().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := b.store.Notification().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := b.store.Revision().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := b.store.Slug().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		// 系列中剩余博文的位置不需要调整，系列按位置排序后重新编号
+		if err := b.store.Series().DeletePosts(ctx, postIDs); err != nil {
+			return err
+		}
+		return b.store.Tag().DeletePostTags(ctx, postIDs)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(postIDs), nil
+}
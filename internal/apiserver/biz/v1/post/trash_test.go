@@ -0,0 +1,120 @@
+package post
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store/storetest"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+)
+
+// 与博文关联、需要随博文一起彻底删除的表
+var postDependents = []any{
+	&model.CommentM{}, &model.PostLikeM{}, &model.BookmarkM{}, &model.NotificationM{},
+	&model.PostRevisionM{}, &model.PostSlugM{}, &model.SeriesPostM{}, &model.PostTagM{},
+}
+
+// countDependents 返回各关联表中属于postID的记录数，键为表名
+func countDependents(t *testing.T, db *gorm.DB, postID string) map[string]int64 {
+	t.Helper()
+	counts := make(map[string]int64, len(postDependents))
+	for _, m := range postDependents {
+		stmt := &gorm.Statement{DB: db}
+		require.NoError(t, stmt.Parse(m))
+		var n int64
+		require.NoError(t, db.Model(m).Where("postID = ?", postID).Count(&n).Error)
+		counts[stmt.Schema.Table] = n
+	}
+	return counts
+}
+
+func TestTrashRestorePurge(t *testing.T) {
+	b := newTestBiz(t, nil)
+	db, _ := storetest.New(t)
+	users := storetest.Users(t, 2)
+	author, reader := users[0], users[1]
+	ctx := storetest.Context(author)
+
+	created, err := b.Create(ctx, &apiv1.CreatePostRequest{Title: "Trash Me", Content: "content", Tags: []string{"go"}})
+	require.NoError(t, err)
+	postID := created.GetPostID()
+	// 修改标题会保存历史版本并生成新的slug
+	_, err = b.Update(ctx, &apiv1.UpdatePostRequest{PostID: postID, Title: ptr.To("Trash Me Later")})
+	require.NoError(t, err)
+
+	series := &model.SeriesM{UserID: author.UserID, Title: "series"}
+	require.NoError(t, db.Create(series).Error)
+	require.NoError(t, db.Create(&model.SeriesPostM{SeriesID: series.SeriesID, PostID: postID, Position: 1}).Error)
+	require.NoError(t, db.Create(&model.CommentM{PostID: postID, UserID: reader.UserID, Content: "nice"}).Error)
+	require.NoError(t, db.Create(&model.PostLikeM{PostID: postID, UserID: reader.UserID}).Error)
+	require.NoError(t, db.Create(&model.BookmarkM{PostID: postID, UserID: reader.UserID}).Error)
+	require.NoError(t, db.Create(&model.NotificationM{UserID: author.UserID, ActorID: reader.UserID, PostID: postID}).Error)
+	require.NoError(t, db.Create(&model.PostPinM{UserID: author.UserID, PostID: postID, Position: 1}).Error)
+
+	before := countDependents(t, db, postID)
+	for table, n := range before {
+		assert.NotZero(t, n, table)
+	}
+
+	_, err = b.Delete(ctx, &apiv1.DeletePostRequest{PostIDs: []string{postID}})
+	require.NoError(t, err)
+	_, err = b.Get(ctx, &apiv1.GetPostRequest{PostID: postID})
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	deleted, err := b.ListDeleted(ctx, &apiv1.ListDeletedPostsRequest{})
+	require.NoError(t, err)
+	require.Len(t, deleted.GetPosts(), 1)
+	assert.Equal(t, postID, deleted.GetPosts()[0].GetPostID())
+	assert.NotNil(t, deleted.GetPosts()[0].GetDeletedAt())
+	// 回收站中的博文不再置顶，其他关联数据保留
+	var pins int64
+	require.NoError(t, db.Model(&model.PostPinM{}).Where("postID = ?", postID).Count(&pins).Error)
+	assert.Zero(t, pins)
+	assert.Equal(t, before, countDependents(t, db, postID))
+
+	// 回收站中的博文仍然占用slug，恢复后原链接继续可用
+	other, err := b.Create(ctx, &apiv1.CreatePostRequest{Title: "Trash Me Later", Content: "content"})
+	require.NoError(t, err)
+	otherPost, err := b.Get(ctx, &apiv1.GetPostRequest{PostID: other.GetPostID()})
+	require.NoError(t, err)
+	assert.Equal(t, "trash-me-later-2", otherPost.GetPost().GetSlug())
+
+	// 只有作者可以恢复
+	_, err = b.Restore(storetest.Context(reader), &apiv1.RestorePostRequest{PostID: postID})
+	assert.ErrorIs(t, err, errno.ErrPostNotFound)
+	_, err = b.Restore(ctx, &apiv1.RestorePostRequest{PostID: postID})
+	require.NoError(t, err)
+	_, err = b.Restore(ctx, &apiv1.RestorePostRequest{PostID: postID})
+	assert.ErrorIs(t, err, errno.ErrPostNotFound)
+
+	restored, err := b.Get(ctx, &apiv1.GetPostRequest{PostID: postID})
+	require.NoError(t, err)
+	assert.Equal(t, "trash-me-later", restored.GetPost().GetSlug())
+	assert.Equal(t, []string{"go"}, restored.GetPost().GetTags())
+	assert.Equal(t, before, countDependents(t, db, postID))
+
+	// 未超过保留期限的博文不会被彻底删除
+	_, err = b.Delete(ctx, &apiv1.DeletePostRequest{PostIDs: []string{postID}})
+	require.NoError(t, err)
+	_, err = b.PurgeDeleted(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, before, countDependents(t, db, postID))
+
+	purged, err := b.PurgeDeleted(ctx, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, purged, 1)
+	for table, n := range countDependents(t, db, postID) {
+		assert.Zero(t, n, table)
+	}
+	_, err = b.store.Post().GetUnscoped(ctx, where.F("postID", postID))
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	// 其他博文的关联数据不受影响
+	assert.NotZero(t, countDependents(t, db, other.GetPostID())["post_slug"])
+}
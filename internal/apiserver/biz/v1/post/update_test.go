@@ -0,0 +1,52 @@
+package post
+
+import (
+	"testing"
+
+	"github.com/onexstack/onexstack/pkg/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store/storetest"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+)
+
+func TestUpdateSkipsNoop(t *testing.T) {
+	b := newTestBiz(t, nil)
+	ctx := storetest.Context(storetest.Users(t, 1)[0])
+
+	created, err := b.Create(ctx, &apiv1.CreatePostRequest{Title: "Same", Content: "content", Tags: []string{"Go", "db"}})
+	require.NoError(t, err)
+	postID := created.GetPostID()
+	before, err := b.store.Post().Get(ctx, where.F("postID", postID))
+	require.NoError(t, err)
+
+	noops := map[string]*apiv1.UpdatePostRequest{
+		"empty":      {PostID: postID},
+		"same title": {PostID: postID, Title: ptr.To("Same"), Content: ptr.To("content")},
+		// 标签规范化后与原标签相同，顺序不同
+		"same tags": {PostID: postID, Tags: []string{"DB", " go "}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags"}}},
+		"same time": {PostID: postID, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"publishAt"}}},
+	}
+	for name, rq := range noops {
+		t.Run(name, func(t *testing.T) {
+			resp, err := b.Update(ctx, rq)
+			require.NoError(t, err)
+			assert.Equal(t, before.Version, resp.GetVersion())
+
+			after, err := b.store.Post().Get(ctx, where.F("postID", postID))
+			require.NoError(t, err)
+			assert.Equal(t, before.Version, after.Version)
+			assert.True(t, before.UpdatedAt.Equal(after.UpdatedAt))
+		})
+	}
+
+	resp, err := b.Update(ctx, &apiv1.UpdatePostRequest{PostID: postID, Tags: []string{"go"}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags"}}})
+	require.NoError(t, err)
+	assert.Equal(t, before.Version+1, resp.GetVersion())
+	resp, err = b.Update(ctx, &apiv1.UpdatePostRequest{PostID: postID, Title: ptr.To("Changed")})
+	require.NoError(t, err)
+	assert.Equal(t, before.Version+2, resp.GetVersion())
+}
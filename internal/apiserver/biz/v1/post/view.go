@@ -0,0 +1,48 @@
+package post
+
+import (
+	"context"
+	"time"
+
+	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+)
+
+// recordView 记录一次博文浏览，登录用户按用户ID去重，匿名用户按客户端IP去重
+func (b *postBiz) recordView(ctx context.Context, postID string) {
+	viewer := contextx.UserID(ctx)
+	if viewer == "" {
+		if ip := contextx.ClientIP(ctx); ip != "" {
+			viewer = "ip:" + ip
+		}
+	}
+	b.views.Add(postID, viewer, time.Now())
+}
+
+// withViewCounts 在数据库中的浏览次数上加上尚未写回的浏览次数
+func (b *postBiz) withViewCounts(posts ...*apiv1.Post) {
+	for _, post := range posts {
+		post.ViewCount += b.views.Pending(post.PostID)
+	}
+}
+
+// FlushViews 在一个事务中写回所有累计的浏览次数，写回失败时放回计数器，等待下一次重试
+func (b *postBiz) FlushViews(ctx context.Context) (int, error) {
+	counts := b.views.Drain(time.Now())
+	if len(counts) == 0 {
+		return 0, nil
+	}
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		for postID, n := range counts {
+			if err := b.store.Post().AddViews(ctx, postID, n); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		b.views.Restore(counts)
+		return 0, err
+	}
+	return len(counts), nil
+}
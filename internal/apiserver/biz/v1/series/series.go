@@ -0,0 +1,184 @@
+package series
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store"
+	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 博文系列，作者可以将多篇博文按顺序组织在一起，读者通过系列导航阅读上一篇和下一篇
+type SeriesBiz interface {
+	Create(ctx context.Context, rq *apiv1.CreateSeriesRequest) (*apiv1.CreateSeriesResponse, error)
+	Get(ctx context.Context, rq *apiv1.GetSeriesRequest) (*apiv1.GetSeriesResponse, error)
+
+	SeriesExpansion
+}
+
+type SeriesExpansion interface {
+	AddPost(ctx context.Context, rq *apiv1.AddPostToSeriesRequest) (*apiv1.AddPostToSeriesResponse, error)
+	Reorder(ctx context.Context, rq *apiv1.ReorderSeriesRequest) (*apiv1.ReorderSeriesResponse, error)
+}
+
+type seriesBiz struct {
+	store store.IStore
+}
+
+var _ SeriesBiz = (*seriesBiz)(nil)
+
+func New(store store.IStore) *seriesBiz {
+	return &seriesBiz{store: store}
+}
+
+func (b *seriesBiz) Create(ctx context.Context, rq *apiv1.CreateSeriesRequest) (*apiv1.CreateSeriesResponse, error) {
+	seriesM := model.SeriesM{
+		UserID:      contextx.UserID(ctx),
+		Title:       rq.GetTitle(),
+		Description: rq.GetDescription(),
+	}
+	if err := b.store.Series().Create(ctx, &seriesM); err != nil {
+		return nil, err
+	}
+	return &apiv1.CreateSeriesResponse{SeriesID: seriesM.SeriesID}, nil
+}
+
+// Get 作者可以看到系列中的全部博文，其他用户只能看到已发布的博文
+func (b *seriesBiz) Get(ctx context.Context, rq *apiv1.GetSeriesRequest) (*apiv1.GetSeriesResponse, error) {
+	seriesM, err := b.store.Series().Get(ctx, where.F("seriesID", rq.GetSeriesID()))
+	if err != nil {
+		return nil, seriesError(err)
+	}
+	series, err := b.withPosts(ctx, seriesM, seriesM.UserID != contextx.UserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.GetSeriesResponse{Series: series}, nil
+}
+
+// AddPost 将当前用户的博文追加到系列末尾
+func (b *seriesBiz) AddPost(ctx context.Context, rq *apiv1.AddPostToSeriesRequest) (*apiv1.AddPostToSeriesResponse, error) {
+	var position int64
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		seriesM, err := b.lockSeries(ctx, rq.GetSeriesID())
+		if err != nil {
+			return err
+		}
+		if _, err := b.store.Post().Get(ctx, where.T(ctx).F("postID", rq.GetPostID())); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errno.ErrPostNotFound
+			}
+			return err
+		}
+		if _, err := b.store.Series().GetPostSeries(ctx, rq.GetPostID()); err == nil {
+			return errno.ErrPostAlreadyInSeries
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		position, err = b.store.Series().NextPosition(ctx, seriesM.SeriesID)
+		if err != nil {
+			return err
+		}
+		err = b.store.Series().AddPost(ctx, &model.SeriesPostM{SeriesID: seriesM.SeriesID, PostID: rq.GetPostID(), Position: position})
+		if err != nil {
+			return err
+		}
+		return b.store.Series().Update(ctx, seriesM)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.AddPostToSeriesResponse{Position: position}, nil
+}
+
+// Reorder 在一个事务中按postIDs的顺序重新设置系列中每篇博文的位置
+func (b *seriesBiz) Reorder(ctx context.Context, rq *apiv1.ReorderSeriesRequest) (*apiv1.ReorderSeriesResponse, error) {
+	var series *apiv1.Series
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		seriesM, err := b.lockSeries(ctx, rq.GetSeriesID())
+		if err != nil {
+			return err
+		}
+		postList, err := b.store.Series().ListPosts(ctx, seriesM.SeriesID)
+		if err != nil {
+			return err
+		}
+		if !samePosts(postList, rq.GetPostIDs()) {
+			return errno.ErrInvalidArgument.WithMessage("postIDs must contain every post in the series exactly once")
+		}
+		for i, postID := range rq.GetPostIDs() {
+			if err := b.store.Series().SetPosition(ctx, seriesM.SeriesID, postID, int64(i+1)); err != nil {
+				return err
+			}
+		}
+		if err := b.store.Series().Update(ctx, seriesM); err != nil {
+			return err
+		}
+		series, err = b.withPosts(ctx, seriesM, false)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.ReorderSeriesResponse{Series: series}, nil
+}
+
+// lockSeries 在事务中锁定当前用户的系列，避免并发修改时出现重复的位置
+func (b *seriesBiz) lockSeries(ctx context.Context, seriesID string) (*model.SeriesM, error) {
+	whr := where.T(ctx).F("seriesID", seriesID).C(clause.Locking{Strength: clause.LockingStrengthUpdate})
+	seriesM, err := b.store.Series().Get(ctx, whr)
+	if err != nil {
+		return nil, seriesError(err)
+	}
+	return seriesM, nil
+}
+
+// withPosts 返回包含博文列表的系列，publishedOnly为true时只包含已发布的博文
+func (b *seriesBiz) withPosts(ctx context.Context, seriesM *model.SeriesM, publishedOnly bool) (*apiv1.Series, error) {
+	postList, err := b.store.Series().ListPosts(ctx, seriesM.SeriesID)
+	if err != nil {
+		return nil, err
+	}
+	series := conversion.SeriesModelToSeriesV1(seriesM)
+	for _, postM := range postList {
+		if publishedOnly && postM.Status != int32(apiv1.PostStatus_Published) {
+			continue
+		}
+		series.Posts = append(series.Posts, conversion.PostModelToSeriesPostV1(postM, int64(len(series.Posts)+1)))
+	}
+	return series, nil
+}
+
+// samePosts 判断postIDs是否与系列中的博文一一对应
+func samePosts(postList []*model.PostM, postIDs []string) bool {
+	if len(postList) != len(postIDs) {
+		return false
+	}
+	members := make(map[string]bool, len(postList))
+	for _, postM := range postList {
+		members[postM.PostID] = true
+	}
+	for _, postID := range postIDs {
+		if !members[postID] {
+			return false
+		}
+		// 删除已匹配的博文，重复的postID在第二次出现时匹配失败
+		delete(members, postID)
+	}
+	return true
+}
+
+func seriesError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errno.ErrSeriesNotFound
+	}
+	return err
+}
@@ -0,0 +1,42 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+)
+
+// 标签随博文一起创建和删除，因此只提供查询接口
+type TagBiz interface {
+	List(ctx context.Context, rq *apiv1.ListTagsRequest) (*apiv1.ListTagsResponse, error)
+
+	TagExpansion
+}
+
+type TagExpansion interface{}
+
+type tagBiz struct {
+	store store.IStore
+}
+
+var _ TagBiz = (*tagBiz)(nil)
+
+func New(store store.IStore) *tagBiz {
+	return &tagBiz{store: store}
+}
+
+// List 统计当前用户博文中各标签的引用次数
+func (b *tagBiz) List(ctx context.Context, rq *apiv1.ListTagsRequest) (*apiv1.ListTagsResponse, error) {
+	usages, err := b.store.Tag().ListUsage(ctx, where.T(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*apiv1.Tag, 0, len(usages))
+	for _, usage := range usages {
+		tags = append(tags, &apiv1.Tag{Name: usage.Name, PostCount: usage.Count})
+	}
+	return &apiv1.ListTagsResponse{Tags: tags}, nil
+}
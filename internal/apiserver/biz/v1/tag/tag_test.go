@@ -0,0 +1,48 @@
+package tag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store/storetest"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+)
+
+func TestListCountsUsage(t *testing.T) {
+	db, st := storetest.New(t)
+	users := storetest.Users(t, 2)
+	ctx := storetest.Context(users[0])
+
+	tags, err := st.Tag().Ensure(ctx, []string{"go", "db"})
+	require.NoError(t, err)
+	tagIDs := make(map[string]int64, len(tags))
+	for _, tag := range tags {
+		tagIDs[tag.Name] = tag.ID
+	}
+	tagPost := func(user *model.UserM, names ...string) *model.PostM {
+		post := &model.PostM{UserID: user.UserID, Title: "post", Content: "content"}
+		require.NoError(t, db.Create(post).Error)
+		ids := make([]int64, 0, len(names))
+		for _, name := range names {
+			ids = append(ids, tagIDs[name])
+		}
+		require.NoError(t, st.Tag().SetPostTags(ctx, post.PostID, ids))
+		return post
+	}
+	tagPost(users[0], "go", "db")
+	tagPost(users[0], "go")
+	// 已删除的博文和其他用户的博文不计入
+	require.NoError(t, db.Delete(tagPost(users[0], "go", "db")).Error)
+	tagPost(users[1], "db")
+
+	resp, err := New(st).List(ctx, &apiv1.ListTagsRequest{})
+	require.NoError(t, err)
+	counts := make(map[string]int64)
+	for _, tag := range resp.GetTags() {
+		counts[tag.GetName()] = tag.GetPostCount()
+	}
+	assert.Equal(t, map[string]int64{"go": 2, "db": 1}, counts)
+}
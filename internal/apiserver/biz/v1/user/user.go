@@ -6,6 +6,9 @@ import (
 
 	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
 	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/conversion"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/fieldmask"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/orderby"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/pagetoken"
 	"github.com/ArthurWang23/miniblog/internal/apiserver/store"
 	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
 	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
@@ -37,6 +40,13 @@ type UserExpansion interface {
 	ListWithBadPerformance(ctx context.Context, rq *apiv1.ListUsersRequest) (*apiv1.ListUsersResponse, error)
 }
 
+// ListUser 允许排序的字段
+var userOrderFields = orderby.Allowlist[model.UserM]{
+	"createdAt": {Column: "createdAt", Value: func(user *model.UserM) any { return user.CreatedAt }},
+	"updatedAt": {Column: "updatedAt", Value: func(user *model.UserM) any { return user.UpdatedAt }},
+	"username":  {Column: "username", Value: func(user *model.UserM) any { return user.Username }},
+}
+
 type userBiz struct {
 	store store.IStore
 	authz *auth.Authz
@@ -68,33 +78,77 @@ func (b *userBiz) Create(ctx context.Context, rq *apiv1.CreateUserRequest) (*api
 	}, nil
 }
 
+// 允许通过updateMask修改的用户字段
+var userUpdatePaths = []string{"username", "nickname", "email", "phone"}
+
+// Update 只更新本次修改的列，避免覆盖其他请求并发修改的字段，例如同时修改的密码
 func (b *userBiz) Update(ctx context.Context, rq *apiv1.UpdateUserRequest) (*apiv1.UpdateUserResponse, error) {
+	paths, err := updateUserPaths(rq)
+	if err != nil {
+		return nil, err
+	}
 	userM, err := b.store.User().Get(ctx, where.T(ctx))
 	if err != nil {
 		return nil, err
 	}
-	if rq.Username != nil {
+	columns := make([]string, 0, len(paths))
+	if paths.Has("username") {
 		userM.Username = rq.GetUsername()
+		columns = append(columns, "username")
 	}
-	if rq.Email != nil {
-		userM.Email = rq.GetEmail()
-	}
-	if rq.Nickname != nil {
+	if paths.Has("nickname") {
 		userM.Nickname = rq.GetNickname()
+		columns = append(columns, "nickname")
 	}
-	if rq.Phone != nil {
+	if paths.Has("email") {
+		userM.Email = rq.GetEmail()
+		columns = append(columns, "email")
+	}
+	if paths.Has("phone") {
 		userM.Phone = rq.GetPhone()
+		columns = append(columns, "phone")
 	}
-	if err := b.store.User().Update(ctx, userM); err != nil {
+	if err := b.store.User().UpdateColumns(ctx, userM, columns...); err != nil {
 		return nil, err
 	}
 	return &apiv1.UpdateUserResponse{}, nil
 }
 
+// updateUserPaths 返回本次需要修改的字段，未设置updateMask时由请求中设置了值的字段决定
+func updateUserPaths(rq *apiv1.UpdateUserRequest) (fieldmask.Paths, error) {
+	if rq.UpdateMask != nil {
+		return fieldmask.Parse(rq.GetUpdateMask(), userUpdatePaths...)
+	}
+	paths := fieldmask.Paths{}
+	if rq.Username != nil {
+		paths.Add("username")
+	}
+	if rq.Nickname != nil {
+		paths.Add("nickname")
+	}
+	if rq.Email != nil {
+		paths.Add("email")
+	}
+	if rq.Phone != nil {
+		paths.Add("phone")
+	}
+	return paths, nil
+}
+
 func (b *userBiz) Delete(ctx context.Context, rq *apiv1.DeleteUserRequest) (*apiv1.DeleteUserResponse, error) {
 	// 只有 root 可以删除用户
 	// 所以这里不用where.T() 因为where.T() 会查询root用户自己
-	if err := b.store.User().Delete(ctx, where.F("userID", rq.GetUserID())); err != nil {
+	// 用户以及用户的通知、关注关系在同一个事务中删除
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.User().Delete(ctx, where.F("userID", rq.GetUserID())); err != nil {
+			return err
+		}
+		if err := b.store.Notification().Delete(ctx, where.F("userID", rq.GetUserID())); err != nil {
+			return err
+		}
+		return b.store.Follow().Delete(ctx, where.NewWhere().Q("followerID = ? OR followeeID = ?", rq.GetUserID(), rq.GetUserID()))
+	})
+	if err != nil {
 		return nil, err
 	}
 	if _, err := b.authz.RemoveGroupingPolicy(rq.GetUserID(), known.RoleUser); err != nil {
@@ -127,10 +181,23 @@ func (b *userBiz) List(ctx context.Context, rq *apiv1.ListUsersRequest) (*apiv1.
 	if contextx.Username(ctx) != known.AdminUsername {
 		whr.T(ctx)
 	}
+	whr.R("createdAt", conversion.TimestampToTime(rq.GetCreatedAfter()), conversion.TimestampToTime(rq.GetCreatedBefore()))
+	whr.R("updatedAt", conversion.TimestampToTime(rq.GetUpdatedAfter()), conversion.TimestampToTime(rq.GetUpdatedBefore()))
+	if rq.GetSkipTotalCount() {
+		whr.NoCount()
+	}
+	orders, err := userOrderFields.Parse(rq.GetOrderBy())
+	if err != nil {
+		return nil, err
+	}
+	if err := pagetoken.Paginate(whr, rq.GetPageToken(), rq.GetLimit(), orders); err != nil {
+		return nil, err
+	}
 	count, userList, err := b.store.User().List(ctx, whr)
 	if err != nil {
 		return nil, err
 	}
+	userList, nextPageToken := pagetoken.NextPage(userList, rq.GetLimit(), func(user *model.UserM) int64 { return user.ID }, orders)
 	var m sync.Map
 	eg, ctx := errgroup.WithContext(ctx)
 	eg.SetLimit(known.MaxErrGroupConcurrency)
@@ -163,8 +230,9 @@ func (b *userBiz) List(ctx context.Context, rq *apiv1.ListUsersRequest) (*apiv1.
 	}
 	log.W(ctx).Debugw("Get users from backend storage", "count", len(users))
 	return &apiv1.ListUsersResponse{
-		TotalCount: count,
-		Users:      users,
+		TotalCount:    count,
+		Users:         users,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
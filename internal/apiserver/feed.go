@@ -0,0 +1,102 @@
+// Copyright 2025 ArthurWang &lt;2826979176@qq.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/arthurwang23/miniblog. The professional
+// version of this repository is https://github.com/arthurwang23/miniblog.
+
+package apiserver
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/feed"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/slug"
+	"github.com/ArthurWang23/miniblog/internal/pkg/log"
+	"github.com/ArthurWang23/miniblog/pkg/errorsx"
+)
+
+// 订阅源不是gRPC接口，gin模式和grpc-gateway模式都通过serveFeed处理请求
+// 全站订阅源为 /feed.rss 和 /feed.atom，作者订阅源为 /u/{username}/feed.rss 和 /u/{username}/feed.atom
+
+// 订阅源的标题
+const siteTitle = "miniblog"
+
+// serveFeed 输出订阅源，username为空时输出全站订阅源
+// 响应只携带按内容计算的ETag，订阅源未变化时对条件请求返回304
+// 不使用Last-Modified：博文被撤回或删除后剩余博文的最大修改时间可能不变甚至变早，会让客户端拿到过期的304
+func (c *ServerConfig) serveFeed(w http.ResponseWriter, r *http.Request, username string, format feed.Format) {
+	posts, err := c.biz.PostV1().ListFeed(r.Context(), username)
+	if err != nil {
+		errx := errorsx.FromError(err)
+		if errx.Code == http.StatusInternalServerError {
+			log.W(r.Context()).Errorw("Failed to list feed posts", "username", username, "err", err)
+		}
+		http.Error(w, errx.Message, errx.Code)
+		return
+	}
+
+	base := baseURL(r)
+	f := &feed.Feed{
+		Title:       siteTitle,
+		Description: "Recent posts on " + siteTitle,
+		Link:        base + "/",
+		Self:        base + r.URL.Path,
+		// 没有博文时使用固定的时间，保证订阅源内容不变时ETag也不变
+		Updated: time.Unix(0, 0),
+		Items:   make([]feed.Item, 0, len(posts)),
+	}
+	if username != "" {
+		f.Title = username + " - " + siteTitle
+		f.Description = "Recent posts by " + username
+		f.Link = base + "/u/" + username
+	}
+	for _, post := range posts {
+		author := post.GetAuthor().GetNickname()
+		if author == "" {
+			author = post.GetAuthor().GetUsername()
+		}
+		updated := post.GetUpdatedAt().AsTime()
+		if updated.After(f.Updated) {
+			f.Updated = updated
+		}
+		f.Items = append(f.Items, feed.Item{
+			ID:         base + "/v1/public/posts/" + post.GetPostID(),
+			Title:      post.GetTitle(),
+			Link:       base + slug.Path(post.GetAuthor().GetUsername(), post.GetSlug()),
+			Author:     author,
+			Categories: post.GetTags(),
+			Content:    post.GetContentHtml(),
+			Published:  post.GetPublishedAt().AsTime(),
+			Updated:    updated,
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := feed.Write(&buf, f, format); err != nil {
+		log.W(r.Context()).Errorw("Failed to render feed", "username", username, "err", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	sum := sha256.Sum256(buf.Bytes())
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+	// 允许缓存，但每次使用前需要通过条件请求确认订阅源是否变化
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Del("Expires")
+	w.Header().Del("Last-Modified")
+	// modtime为零时ServeContent只根据If-None-Match判断，不设置Last-Modified也不处理If-Modified-Since
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(buf.Bytes()))
+}
+
+// baseURL 返回当前请求的站点地址，订阅源中的链接必须是绝对地址
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
@@ -0,0 +1,55 @@
+package apiserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/biz"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/feed"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store/storetest"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+)
+
+func TestFeedRevalidatesAfterDelete(t *testing.T) {
+	db, st := storetest.New(t)
+	user := storetest.Users(t, 1)[0]
+	c := &ServerConfig{biz: biz.NewBiz(st, nil, nil, 0, nil)}
+
+	now := time.Now()
+	posts := make([]*model.PostM, 0, 2)
+	for i := range 2 {
+		publishedAt := now.Add(time.Duration(i) * time.Minute)
+		post := &model.PostM{UserID: user.UserID, Title: "post", Content: "content",
+			Status: int32(apiv1.PostStatus_Published), PublishedAt: &publishedAt}
+		require.NoError(t, db.Create(post).Error)
+		posts = append(posts, post)
+	}
+
+	get := func(header, value string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/u/"+user.Username+"/feed.rss", nil)
+		if header != "" {
+			r.Header.Set(header, value)
+		}
+		c.serveFeed(w, r, user.Username, feed.RSS)
+		return w
+	}
+
+	w := get("", "")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	assert.Empty(t, w.Header().Get("Last-Modified"))
+	assert.Equal(t, http.StatusNotModified, get("If-None-Match", etag).Code)
+
+	// 删除最新的博文后剩余博文的修改时间不变，订阅源仍然需要重新下发
+	require.NoError(t, db.Delete(posts[1]).Error)
+	assert.Equal(t, http.StatusOK, get("If-None-Match", etag).Code)
+	assert.Equal(t, http.StatusOK, get("If-Modified-Since", now.Add(time.Hour).UTC().Format(http.TimeFormat)).Code)
+}
@@ -8,8 +8,12 @@ package apiserver
 
 import (
 	"context"
+	"net/http"
 
 	handler "github.com/ArthurWang23/miniblog/internal/apiserver/handler/grpc"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/etag"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/feed"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/slug"
 	mw "github.com/ArthurWang23/miniblog/internal/pkg/middleware/grpc"
 	"github.com/ArthurWang23/miniblog/internal/pkg/server"
 	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
@@ -18,6 +22,7 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/selector"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 type grpcServer struct {
@@ -37,6 +42,7 @@ func (c *ServerConfig) NewGRPCServerOr() (server.Server, error) {
 	serverOptions := []grpc.ServerOption{
 		grpc.ChainUnaryInterceptor(
 			mw.RequestIDInterceptor(),
+			mw.ClientIPInterceptor(c.cfg.TrustedProxies...),
 			// 给grpc服务器添加认证拦截器和白名单功能
 			// 在认证时排出白名单中的方法
 			selector.UnaryServerInterceptor(mw.AuthnInterceptor(c.retriever), NewAuthnWhiteListMatcher()),
@@ -45,6 +51,13 @@ func (c *ServerConfig) NewGRPCServerOr() (server.Server, error) {
 			mw.DefaulterInterceptor(),
 			mw.ValidatorInterceptor(genericvalidation.NewValidator(c.val)),
 		),
+		// 流式调用（如上传媒体文件）同样需要认证和授权
+		grpc.ChainStreamInterceptor(
+			mw.RequestIDStreamInterceptor(),
+			selector.StreamServerInterceptor(mw.AuthnStreamInterceptor(c.retriever), NewAuthnWhiteListMatcher()),
+			selector.StreamServerInterceptor(mw.AuthzStreamInterceptor(c.authz), NewAuthzWhiteListMatcher()),
+			mw.ValidatorStreamInterceptor(genericvalidation.NewValidator(c.val)),
+		),
 	}
 
 	grpcsrv, err := server.NewGRPCServer(
@@ -75,8 +88,36 @@ func (c *ServerConfig) NewGRPCServerOr() (server.Server, error) {
 		c.cfg.GRPCOptions,
 		c.cfg.TLSOptions,
 		func(mux *runtime.ServeMux, conn *grpc.ClientConn) error {
-			return apiv1.RegisterMiniBlogHandler(context.Background(), mux, conn)
+			// 上传的媒体文件，匿名用户也可以访问
+			err := mux.HandlePath(http.MethodGet, mediaURLPrefix+"/{key}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+				serveMedia(c.cfg.MediaDir, w, r, params["key"])
+			})
+			if err != nil {
+				return err
+			}
+			if err := apiv1.RegisterMiniBlogHandler(context.Background(), mux, conn); err != nil {
+				return err
+			}
+			// 订阅源，匿名用户也可以访问
+			// 后注册的路由优先匹配，需要在生成的路由之后注册，避免被 /u/{username}/{slug} 匹配
+			for _, format := range []feed.Format{feed.RSS, feed.Atom} {
+				err := mux.HandlePath(http.MethodGet, "/feed."+string(format), func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+					c.serveFeed(w, r, "", format)
+				})
+				if err != nil {
+					return err
+				}
+				err = mux.HandlePath(http.MethodGet, "/u/{username}/feed."+string(format), func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+					c.serveFeed(w, r, params["username"], format)
+				})
+				if err != nil {
+					return err
+				}
+			}
+			return nil
 		},
+		runtime.WithForwardResponseOption(redirectMovedPost),
+		runtime.WithForwardResponseOption(setPostETag),
 	)
 	if err != nil {
 		return nil, err
@@ -91,6 +132,28 @@ func (c *ServerConfig) NewGRPCServerOr() (server.Server, error) {
 	}, nil
 }
 
+// redirectMovedPost 通过旧的slug访问博文时，网关返回301并跳转到博文当前的链接
+func redirectMovedPost(ctx context.Context, w http.ResponseWriter, resp proto.Message) error {
+	rp, ok := resp.(*apiv1.GetPostBySlugResponse)
+	if !ok || !rp.GetMoved() {
+		return nil
+	}
+	w.Header().Set("Location", slug.Path(rp.GetPost().GetAuthor().GetUsername(), rp.GetPost().GetSlug()))
+	w.WriteHeader(http.StatusMovedPermanently)
+	return nil
+}
+
+// setPostETag 查询和修改博文时，网关通过ETag响应头返回博文的版本号，客户端修改博文时可以通过If-Match请求头回传
+func setPostETag(ctx context.Context, w http.ResponseWriter, resp proto.Message) error {
+	switch rp := resp.(type) {
+	case *apiv1.GetPostResponse:
+		w.Header().Set("ETag", etag.Format(rp.GetPost().GetVersion()))
+	case *apiv1.UpdatePostResponse:
+		w.Header().Set("ETag", etag.Format(rp.GetVersion()))
+	}
+	return nil
+}
+
 func (s *grpcServer) RunOrDie() {
 	s.srv.RunOrDie()
 }
@@ -102,9 +165,13 @@ func (s *grpcServer) GracefulStop(ctx context.Context) {
 // 创建方法匹配器，使用MatchFunc定义一组无需认证的方法（如健康检查，用户创建，登录）
 func NewAuthnWhiteListMatcher() selector.Matcher {
 	whitelist := map[string]struct{}{
-		apiv1.MiniBlog_Healthz_FullMethodName:    {},
-		apiv1.MiniBlog_CreateUser_FullMethodName: {},
-		apiv1.MiniBlog_Login_FullMethodName:      {},
+		apiv1.MiniBlog_Healthz_FullMethodName:         {},
+		apiv1.MiniBlog_CreateUser_FullMethodName:      {},
+		apiv1.MiniBlog_Login_FullMethodName:           {},
+		apiv1.MiniBlog_ListPublicPosts_FullMethodName: {},
+		apiv1.MiniBlog_GetPublicPost_FullMethodName:   {},
+		apiv1.MiniBlog_GetPostBySlug_FullMethodName:   {},
+		apiv1.MiniBlog_ListComments_FullMethodName:    {},
 	}
 	return selector.MatchFunc(func(ctx context.Context, call interceptors.CallMeta) bool {
 		_, ok := whitelist[call.FullMethod()]
@@ -114,9 +181,13 @@ func NewAuthnWhiteListMatcher() selector.Matcher {
 
 func NewAuthzWhiteListMatcher() selector.Matcher {
 	whitelist := map[string]struct{}{
-		apiv1.MiniBlog_Healthz_FullMethodName:    {},
-		apiv1.MiniBlog_CreateUser_FullMethodName: {},
-		apiv1.MiniBlog_Login_FullMethodName:      {},
+		apiv1.MiniBlog_Healthz_FullMethodName:         {},
+		apiv1.MiniBlog_CreateUser_FullMethodName:      {},
+		apiv1.MiniBlog_Login_FullMethodName:           {},
+		apiv1.MiniBlog_ListPublicPosts_FullMethodName: {},
+		apiv1.MiniBlog_GetPublicPost_FullMethodName:   {},
+		apiv1.MiniBlog_GetPostBySlug_FullMethodName:   {},
+		apiv1.MiniBlog_ListComments_FullMethodName:    {},
 	}
 	return selector.MatchFunc(func(ctx context.Context, call interceptors.CallMeta) bool {
 		_, ok := whitelist[call.FullMethod()]
@@ -0,0 +1,19 @@
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+)
+
+func (h *Handler) AddBookmark(ctx context.Context, rq *apiv1.AddBookmarkRequest) (*apiv1.AddBookmarkResponse, error) {
+	return h.biz.BookmarkV1().Add(ctx, rq)
+}
+
+func (h *Handler) RemoveBookmark(ctx context.Context, rq *apiv1.RemoveBookmarkRequest) (*apiv1.RemoveBookmarkResponse, error) {
+	return h.biz.BookmarkV1().Remove(ctx, rq)
+}
+
+func (h *Handler) ListBookmarks(ctx context.Context, rq *apiv1.ListBookmarksRequest) (*apiv1.ListBookmarksResponse, error) {
+	return h.biz.BookmarkV1().List(ctx, rq)
+}
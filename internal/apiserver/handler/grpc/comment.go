@@ -0,0 +1,19 @@
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+)
+
+func (h *Handler) CreateComment(ctx context.Context, rq *apiv1.CreateCommentRequest) (*apiv1.CreateCommentResponse, error) {
+	return h.biz.CommentV1().Create(ctx, rq)
+}
+
+func (h *Handler) ListComments(ctx context.Context, rq *apiv1.ListCommentsRequest) (*apiv1.ListCommentsResponse, error) {
+	return h.biz.CommentV1().List(ctx, rq)
+}
+
+func (h *Handler) DeleteComment(ctx context.Context, rq *apiv1.DeleteCommentRequest) (*apiv1.DeleteCommentResponse, error) {
+	return h.biz.CommentV1().Delete(ctx, rq)
+}
@@ -0,0 +1,27 @@
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+)
+
+func (h *Handler) Follow(ctx context.Context, rq *apiv1.FollowRequest) (*apiv1.FollowResponse, error) {
+	return h.biz.FollowV1().Follow(ctx, rq)
+}
+
+func (h *Handler) Unfollow(ctx context.Context, rq *apiv1.UnfollowRequest) (*apiv1.UnfollowResponse, error) {
+	return h.biz.FollowV1().Unfollow(ctx, rq)
+}
+
+func (h *Handler) ListFollowers(ctx context.Context, rq *apiv1.ListFollowersRequest) (*apiv1.ListFollowersResponse, error) {
+	return h.biz.FollowV1().ListFollowers(ctx, rq)
+}
+
+func (h *Handler) ListFollowing(ctx context.Context, rq *apiv1.ListFollowingRequest) (*apiv1.ListFollowingResponse, error) {
+	return h.biz.FollowV1().ListFollowing(ctx, rq)
+}
+
+func (h *Handler) GetTimeline(ctx context.Context, rq *apiv1.GetTimelineRequest) (*apiv1.GetTimelineResponse, error) {
+	return h.biz.PostV1().GetTimeline(ctx, rq)
+}
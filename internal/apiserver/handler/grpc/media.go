@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"google.golang.org/grpc"
+)
+
+type uploadMediaServer = grpc.ClientStreamingServer[apiv1.UploadMediaRequest, apiv1.UploadMediaResponse]
+
+// UploadMedia 第一条消息携带文件信息，之后的消息携带文件内容，客户端关闭发送后返回上传结果
+func (h *Handler) UploadMedia(stream uploadMediaServer) error {
+	rq, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	info := rq.GetInfo()
+	if info == nil {
+		return errno.ErrInvalidArgument.WithMessage("the first message must contain media info")
+	}
+	resp, err := h.biz.MediaV1().Upload(stream.Context(), info, &chunkReader{stream: stream})
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(resp)
+}
+
+// chunkReader 将客户端流中的文件内容分片转换为io.Reader，客户端关闭发送时返回io.EOF
+type chunkReader struct {
+	stream uploadMediaServer
+	buf    []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		rq, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if rq.GetInfo() != nil {
+			return 0, errno.ErrInvalidArgument.WithMessage("media info can only be sent in the first message")
+		}
+		r.buf = rq.GetChunk()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
@@ -0,0 +1,19 @@
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+)
+
+func (h *Handler) ListModeratedPosts(ctx context.Context, rq *apiv1.ListModeratedPostsRequest) (*apiv1.ListModeratedPostsResponse, error) {
+	return h.biz.PostV1().ListModerated(ctx, rq)
+}
+
+func (h *Handler) ApproveModeratedPost(ctx context.Context, rq *apiv1.ApproveModeratedPostRequest) (*apiv1.ApproveModeratedPostResponse, error) {
+	return h.biz.PostV1().ApproveModerated(ctx, rq)
+}
+
+func (h *Handler) RejectModeratedPost(ctx context.Context, rq *apiv1.RejectModeratedPostRequest) (*apiv1.RejectModeratedPostResponse, error) {
+	return h.biz.PostV1().RejectModerated(ctx, rq)
+}
@@ -0,0 +1,19 @@
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+)
+
+func (h *Handler) ListNotifications(ctx context.Context, rq *apiv1.ListNotificationsRequest) (*apiv1.ListNotificationsResponse, error) {
+	return h.biz.NotificationV1().List(ctx, rq)
+}
+
+func (h *Handler) MarkNotificationsRead(ctx context.Context, rq *apiv1.MarkNotificationsReadRequest) (*apiv1.MarkNotificationsReadResponse, error) {
+	return h.biz.NotificationV1().MarkRead(ctx, rq)
+}
+
+func (h *Handler) GetUnreadNotificationCount(ctx context.Context, rq *apiv1.GetUnreadNotificationCountRequest) (*apiv1.GetUnreadNotificationCountResponse, error) {
+	return h.biz.NotificationV1().GetUnreadCount(ctx, rq)
+}
@@ -3,14 +3,29 @@ package grpc
 import (
 	"context"
 
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/etag"
 	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"google.golang.org/grpc/metadata"
 )
 
+// 通过网关调用时，HTTP的If-Match请求头以该键传递
+const gatewayIfMatchKey = "grpcgateway-if-match"
+
 func (h *Handler) CreatePost(ctx context.Context, rq *apiv1.CreatePostRequest) (*apiv1.CreatePostResponse, error) {
 	return h.biz.PostV1().Create(ctx, rq)
 }
 
+// UpdatePost If-Match请求头与请求中的expectedVersion同时存在时，以请求头为准
 func (h *Handler) UpdatePost(ctx context.Context, rq *apiv1.UpdatePostRequest) (*apiv1.UpdatePostResponse, error) {
+	if values := metadata.ValueFromIncomingContext(ctx, gatewayIfMatchKey); len(values) > 0 {
+		version, err := etag.ParseIfMatch(values[0])
+		if err != nil {
+			return nil, err
+		}
+		if version != nil {
+			rq.ExpectedVersion = version
+		}
+	}
 	return h.biz.PostV1().Update(ctx, rq)
 }
 
@@ -25,3 +40,71 @@ func (h *Handler) GetPost(ctx context.Context, rq *apiv1.GetPostRequest) (*apiv1
 func (h *Handler) ListPost(ctx context.Context, rq *apiv1.ListPostRequest) (*apiv1.ListPostResponse, error) {
 	return h.biz.PostV1().List(ctx, rq)
 }
+
+func (h *Handler) PublishPost(ctx context.Context, rq *apiv1.PublishPostRequest) (*apiv1.PublishPostResponse, error) {
+	return h.biz.PostV1().Publish(ctx, rq)
+}
+
+func (h *Handler) UnpublishPost(ctx context.Context, rq *apiv1.UnpublishPostRequest) (*apiv1.UnpublishPostResponse, error) {
+	return h.biz.PostV1().Unpublish(ctx, rq)
+}
+
+func (h *Handler) ArchivePost(ctx context.Context, rq *apiv1.ArchivePostRequest) (*apiv1.ArchivePostResponse, error) {
+	return h.biz.PostV1().Archive(ctx, rq)
+}
+
+func (h *Handler) ListPublicPosts(ctx context.Context, rq *apiv1.ListPublicPostsRequest) (*apiv1.ListPublicPostsResponse, error) {
+	return h.biz.PostV1().ListPublic(ctx, rq)
+}
+
+func (h *Handler) GetPublicPost(ctx context.Context, rq *apiv1.GetPublicPostRequest) (*apiv1.GetPublicPostResponse, error) {
+	return h.biz.PostV1().GetPublic(ctx, rq)
+}
+
+func (h *Handler) SearchPosts(ctx context.Context, rq *apiv1.SearchPostsRequest) (*apiv1.SearchPostsResponse, error) {
+	return h.biz.PostV1().Search(ctx, rq)
+}
+
+func (h *Handler) LikePost(ctx context.Context, rq *apiv1.LikePostRequest) (*apiv1.LikePostResponse, error) {
+	return h.biz.PostV1().Like(ctx, rq)
+}
+
+func (h *Handler) UnlikePost(ctx context.Context, rq *apiv1.UnlikePostRequest) (*apiv1.UnlikePostResponse, error) {
+	return h.biz.PostV1().Unlike(ctx, rq)
+}
+
+func (h *Handler) PinPost(ctx context.Context, rq *apiv1.PinPostRequest) (*apiv1.PinPostResponse, error) {
+	return h.biz.PostV1().Pin(ctx, rq)
+}
+
+func (h *Handler) UnpinPost(ctx context.Context, rq *apiv1.UnpinPostRequest) (*apiv1.UnpinPostResponse, error) {
+	return h.biz.PostV1().Unpin(ctx, rq)
+}
+
+func (h *Handler) GetPostBySlug(ctx context.Context, rq *apiv1.GetPostBySlugRequest) (*apiv1.GetPostBySlugResponse, error) {
+	return h.biz.PostV1().GetBySlug(ctx, rq)
+}
+
+func (h *Handler) ListPostRevisions(ctx context.Context, rq *apiv1.ListPostRevisionsRequest) (*apiv1.ListPostRevisionsResponse, error) {
+	return h.biz.PostV1().ListRevisions(ctx, rq)
+}
+
+func (h *Handler) GetPostRevision(ctx context.Context, rq *apiv1.GetPostRevisionRequest) (*apiv1.GetPostRevisionResponse, error) {
+	return h.biz.PostV1().GetRevision(ctx, rq)
+}
+
+func (h *Handler) RestorePostRevision(ctx context.Context, rq *apiv1.RestorePostRevisionRequest) (*apiv1.RestorePostRevisionResponse, error) {
+	return h.biz.PostV1().RestoreRevision(ctx, rq)
+}
+
+func (h *Handler) DiffPostRevisions(ctx context.Context, rq *apiv1.DiffPostRevisionsRequest) (*apiv1.DiffPostRevisionsResponse, error) {
+	return h.biz.PostV1().DiffRevisions(ctx, rq)
+}
+
+func (h *Handler) ListDeletedPosts(ctx context.Context, rq *apiv1.ListDeletedPostsRequest) (*apiv1.ListDeletedPostsResponse, error) {
+	return h.biz.PostV1().ListDeleted(ctx, rq)
+}
+
+func (h *Handler) RestorePost(ctx context.Context, rq *apiv1.RestorePostRequest) (*apiv1.RestorePostResponse, error) {
+	return h.biz.PostV1().Restore(ctx, rq)
+}
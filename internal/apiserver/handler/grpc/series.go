@@ -0,0 +1,23 @@
+package grpc
+
+import (
+	"context"
+
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+)
+
+func (h *Handler) CreateSeries(ctx context.Context, rq *apiv1.CreateSeriesRequest) (*apiv1.CreateSeriesResponse, error) {
+	return h.biz.SeriesV1().Create(ctx, rq)
+}
+
+func (h *Handler) AddPostToSeries(ctx context.Context, rq *apiv1.AddPostToSeriesRequest) (*apiv1.AddPostToSeriesResponse, error) {
+	return h.biz.SeriesV1().AddPost(ctx, rq)
+}
+
+func (h *Handler) ReorderSeries(ctx context.Context, rq *apiv1.ReorderSeriesRequest) (*apiv1.ReorderSeriesResponse, error) {
+	return h.biz.SeriesV1().Reorder(ctx, rq)
+}
+
+func (h *Handler) GetSeries(ctx context.Context, rq *apiv1.GetSeriesRequest) (*apiv1.GetSeriesResponse, error) {
+	return h.biz.SeriesV1().Get(ctx, rq)
+}
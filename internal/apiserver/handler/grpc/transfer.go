@@ -0,0 +1,44 @@
+package grpc
+
+import (
+	"errors"
+	"io"
+
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"google.golang.org/grpc"
+)
+
+// ExportPosts 每条消息对应一篇导出的博文
+func (h *Handler) ExportPosts(rq *apiv1.ExportPostsRequest, stream grpc.ServerStreamingServer[apiv1.ExportPostsResponse]) error {
+	return h.biz.PostV1().Export(stream.Context(), rq, stream.Send)
+}
+
+// ImportPosts 每条消息携带一篇待导入的博文，格式和默认作者从第一条消息中读取
+func (h *Handler) ImportPosts(stream grpc.ClientStreamingServer[apiv1.ImportPostsRequest, apiv1.ImportPostsResponse]) error {
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return stream.SendAndClose(&apiv1.ImportPostsResponse{})
+	}
+	if err != nil {
+		return err
+	}
+	pending := first.GetData()
+	next := func() ([]byte, error) {
+		// 第一条消息可以只携带格式和作者
+		if len(pending) > 0 {
+			data := pending
+			pending = nil
+			return data, nil
+		}
+		rq, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return rq.GetData(), nil
+	}
+	resp, err := h.biz.PostV1().Import(stream.Context(), first.GetFormat(), first.GetAuthor(), next)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(resp)
+}
@@ -0,0 +1,18 @@
+package http
+
+import (
+	"github.com/ArthurWang23/miniblog/pkg/core"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) AddBookmark(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.BookmarkV1().Add, h.val.ValidateAddBookmarkRequest)
+}
+
+func (h *Handler) RemoveBookmark(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.BookmarkV1().Remove, h.val.ValidateRemoveBookmarkRequest)
+}
+
+func (h *Handler) ListBookmarks(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.BookmarkV1().List, h.val.ValidateListBookmarksRequest)
+}
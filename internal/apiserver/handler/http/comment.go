@@ -0,0 +1,18 @@
+package http
+
+import (
+	"github.com/ArthurWang23/miniblog/pkg/core"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) CreateComment(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.CommentV1().Create, h.val.ValidateCreateCommentRequest)
+}
+
+func (h *Handler) ListComments(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.CommentV1().List, h.val.ValidateListCommentsRequest)
+}
+
+func (h *Handler) DeleteComment(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.CommentV1().Delete, h.val.ValidateDeleteCommentRequest)
+}
@@ -0,0 +1,26 @@
+package http
+
+import (
+	"github.com/ArthurWang23/miniblog/pkg/core"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) Follow(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.FollowV1().Follow, h.val.ValidateFollowRequest)
+}
+
+func (h *Handler) Unfollow(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.FollowV1().Unfollow, h.val.ValidateUnfollowRequest)
+}
+
+func (h *Handler) ListFollowers(c *gin.Context) {
+	core.HandleUriQueryRequest(c, h.biz.FollowV1().ListFollowers, h.val.ValidateListFollowersRequest)
+}
+
+func (h *Handler) ListFollowing(c *gin.Context) {
+	core.HandleUriQueryRequest(c, h.biz.FollowV1().ListFollowing, h.val.ValidateListFollowingRequest)
+}
+
+func (h *Handler) GetTimeline(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PostV1().GetTimeline, h.val.ValidateGetTimelineRequest)
+}
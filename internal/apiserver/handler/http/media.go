@@ -0,0 +1,40 @@
+package http
+
+import (
+	"errors"
+	"io"
+
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/core"
+	"github.com/gin-gonic/gin"
+)
+
+// 上传文件在multipart表单中的字段名
+const mediaFormField = "file"
+
+// UploadMedia 以流的方式读取multipart表单中的文件，不会将整个文件缓存在内存或临时文件中
+func (h *Handler) UploadMedia(c *gin.Context) {
+	mr, err := c.Request.MultipartReader()
+	if err != nil {
+		core.WriteResponse(c, nil, errno.ErrBind.WithMessage("%s", err.Error()))
+		return
+	}
+	for {
+		part, err := mr.NextPart()
+		if errors.Is(err, io.EOF) {
+			core.WriteResponse(c, nil, errno.ErrInvalidArgument.WithMessage("form field %q is required", mediaFormField))
+			return
+		}
+		if err != nil {
+			core.WriteResponse(c, nil, errno.ErrBind.WithMessage("%s", err.Error()))
+			return
+		}
+		if part.FormName() != mediaFormField {
+			continue
+		}
+		resp, err := h.biz.MediaV1().Upload(c.Request.Context(), &apiv1.MediaInfo{Filename: part.FileName()}, part)
+		core.WriteResponse(c, resp, err)
+		return
+	}
+}
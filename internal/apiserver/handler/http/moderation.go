@@ -0,0 +1,18 @@
+package http
+
+import (
+	"github.com/ArthurWang23/miniblog/pkg/core"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) ListModeratedPosts(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PostV1().ListModerated, h.val.ValidateListModeratedPostsRequest)
+}
+
+func (h *Handler) ApproveModeratedPost(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().ApproveModerated, h.val.ValidateApproveModeratedPostRequest)
+}
+
+func (h *Handler) RejectModeratedPost(c *gin.Context) {
+	core.HandleUriJSONRequest(c, h.biz.PostV1().RejectModerated, h.val.ValidateRejectModeratedPostRequest)
+}
@@ -0,0 +1,18 @@
+package http
+
+import (
+	"github.com/ArthurWang23/miniblog/pkg/core"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) ListNotifications(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.NotificationV1().List, h.val.ValidateListNotificationsRequest)
+}
+
+func (h *Handler) MarkNotificationsRead(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.NotificationV1().MarkRead, h.val.ValidateMarkNotificationsReadRequest)
+}
+
+func (h *Handler) GetUnreadNotificationCount(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.NotificationV1().GetUnreadCount, h.val.ValidateGetUnreadNotificationCountRequest)
+}
@@ -1,16 +1,54 @@
 package http
 
 import (
+	"net/http"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/etag"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/slug"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
 	"github.com/ArthurWang23/miniblog/pkg/core"
 	"github.com/gin-gonic/gin"
 )
 
+// CreatePost 请求体按照protojson解析，publishAt使用RFC3339字符串
 func (h *Handler) CreatePost(c *gin.Context) {
-	core.HandleJSONRequest(c, h.biz.PostV1().Create)
+	core.HandleProtoJSONRequest(c, h.biz.PostV1().Create, h.val.ValidateCreatePostRequest)
 }
 
+// UpdatePost 与PatchPost使用相同的解析方式，保证publishAt等字段格式一致
 func (h *Handler) UpdatePost(c *gin.Context) {
-	core.HandleJSONRequest(c, h.biz.PostV1().Update)
+	h.updatePost(c, core.ShouldBindUriProtoJSON[apiv1.UpdatePostRequest], h.val.ValidateUpdatePostRequest)
+}
+
+// PatchPost 请求体按照protojson解析，通过updateMask指定需要修改的字段
+func (h *Handler) PatchPost(c *gin.Context) {
+	h.updatePost(c, core.ShouldBindUriProtoJSON[apiv1.UpdatePostRequest], h.val.ValidateUpdatePostRequest)
+}
+
+// updatePost If-Match请求头与请求体中的expectedVersion同时存在时，以请求头为准
+func (h *Handler) updatePost(
+	c *gin.Context,
+	bind func(*gin.Context, *apiv1.UpdatePostRequest, ...core.Validator[apiv1.UpdatePostRequest]) error,
+	validators ...core.Validator[apiv1.UpdatePostRequest],
+) {
+	var rq apiv1.UpdatePostRequest
+	if err := bind(c, &rq, validators...); err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+	version, err := etag.ParseIfMatch(c.GetHeader("If-Match"))
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+	if version != nil {
+		rq.ExpectedVersion = version
+	}
+	resp, err := h.biz.PostV1().Update(c.Request.Context(), &rq)
+	if err == nil {
+		c.Header("ETag", etag.Format(resp.GetVersion()))
+	}
+	core.WriteResponse(c, resp, err)
 }
 
 func (h *Handler) DeletePost(c *gin.Context) {
@@ -18,10 +56,98 @@ func (h *Handler) DeletePost(c *gin.Context) {
 }
 
 func (h *Handler) GetPost(c *gin.Context) {
-	core.HandleUriRequest(c, h.biz.PostV1().Get)
+	var rq apiv1.GetPostRequest
+	if err := core.ShouldBindUri(c, &rq); err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+	resp, err := h.biz.PostV1().Get(c.Request.Context(), &rq)
+	if err == nil {
+		c.Header("ETag", etag.Format(resp.GetPost().GetVersion()))
+	}
+	core.WriteResponse(c, resp, err)
 }
 
 func (h *Handler) ListPost(c *gin.Context) {
 	// 显式校验方法
-	core.HandleQueryRequest(c, h.biz.PostV1().List, h.val.ValidateListPostRequest)
+	core.HandleProtoQueryRequest(c, h.biz.PostV1().List, h.val.ValidateListPostRequest)
+}
+
+func (h *Handler) PublishPost(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().Publish, h.val.ValidatePublishPostRequest)
+}
+
+func (h *Handler) UnpublishPost(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().Unpublish, h.val.ValidateUnpublishPostRequest)
+}
+
+func (h *Handler) ArchivePost(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().Archive, h.val.ValidateArchivePostRequest)
+}
+
+func (h *Handler) ListPublicPosts(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PostV1().ListPublic, h.val.ValidateListPublicPostsRequest)
+}
+
+func (h *Handler) GetPublicPost(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().GetPublic, h.val.ValidateGetPublicPostRequest)
+}
+
+// GetPostBySlug 通过旧的slug访问时返回301，跳转到博文当前的链接
+func (h *Handler) GetPostBySlug(c *gin.Context) {
+	var rq apiv1.GetPostBySlugRequest
+	if err := core.ShouldBindUri(c, &rq, h.val.ValidateGetPostBySlugRequest); err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+	resp, err := h.biz.PostV1().GetBySlug(c.Request.Context(), &rq)
+	if err == nil && resp.GetMoved() {
+		c.Redirect(http.StatusMovedPermanently, slug.Path(resp.GetPost().GetAuthor().GetUsername(), resp.GetPost().GetSlug()))
+		return
+	}
+	core.WriteResponse(c, resp, err)
+}
+
+func (h *Handler) SearchPosts(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PostV1().Search, h.val.ValidateSearchPostsRequest)
+}
+
+func (h *Handler) LikePost(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().Like, h.val.ValidateLikePostRequest)
+}
+
+func (h *Handler) UnlikePost(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().Unlike, h.val.ValidateUnlikePostRequest)
+}
+
+func (h *Handler) PinPost(c *gin.Context) {
+	core.HandleUriJSONRequest(c, h.biz.PostV1().Pin, h.val.ValidatePinPostRequest)
+}
+
+func (h *Handler) UnpinPost(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().Unpin, h.val.ValidateUnpinPostRequest)
+}
+
+func (h *Handler) ListPostRevisions(c *gin.Context) {
+	core.HandleUriQueryRequest(c, h.biz.PostV1().ListRevisions, h.val.ValidateListPostRevisionsRequest)
+}
+
+func (h *Handler) GetPostRevision(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().GetRevision, h.val.ValidateGetPostRevisionRequest)
+}
+
+func (h *Handler) RestorePostRevision(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().RestoreRevision, h.val.ValidateRestorePostRevisionRequest)
+}
+
+func (h *Handler) DiffPostRevisions(c *gin.Context) {
+	core.HandleUriQueryRequest(c, h.biz.PostV1().DiffRevisions, h.val.ValidateDiffPostRevisionsRequest)
+}
+
+func (h *Handler) ListDeletedPosts(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PostV1().ListDeleted, h.val.ValidateListDeletedPostsRequest)
+}
+
+func (h *Handler) RestorePost(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.PostV1().Restore, h.val.ValidateRestorePostRequest)
 }
@@ -0,0 +1,78 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/biz"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/validation"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store/storetest"
+	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
+)
+
+func newTestRouter(t *testing.T, user *model.UserM) (*gin.Engine, *Handler) {
+	_, st := storetest.New(t)
+	h := NewHandler(biz.NewBiz(st, nil, nil, 0, nil), validation.New(st))
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		ctx := contextx.WithUsername(contextx.WithUserID(c.Request.Context(), user.UserID), user.Username)
+		c.Request = c.Request.WithContext(ctx)
+	})
+	r.POST("/v1/posts", h.CreatePost)
+	r.PUT("/v1/posts/:postID", h.UpdatePost)
+	r.POST("/v1/admin/posts/import", h.ImportPosts)
+	return r, h
+}
+
+func serve(r *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestPostBodyAcceptsRFC3339PublishAt(t *testing.T) {
+	user := storetest.Users(t, 1)[0]
+	r, _ := newTestRouter(t, user)
+	db, _ := storetest.New(t)
+
+	publishAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	w := serve(r, http.MethodPost, "/v1/posts",
+		fmt.Sprintf(`{"title":"scheduled","content":"body","publishAt":%q}`, publishAt.Format(time.RFC3339)))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var post model.PostM
+	require.NoError(t, db.Where("userID = ?", user.UserID).First(&post).Error)
+	require.NotNil(t, post.PublishAt)
+	assert.True(t, publishAt.Equal(*post.PublishAt))
+
+	later := publishAt.Add(time.Hour)
+	w = serve(r, http.MethodPut, "/v1/posts/"+post.PostID,
+		fmt.Sprintf(`{"publishAt":%q}`, later.Format(time.RFC3339)))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	require.NoError(t, db.Where("postID = ?", post.PostID).First(&post).Error)
+	require.NotNil(t, post.PublishAt)
+	assert.True(t, later.Equal(*post.PublishAt))
+}
+
+func TestCreatePostValidatesBody(t *testing.T) {
+	user := storetest.Users(t, 1)[0]
+	r, _ := newTestRouter(t, user)
+
+	past := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	w := serve(r, http.MethodPost, "/v1/posts", fmt.Sprintf(`{"title":"t","content":"c","publishAt":%q}`, past))
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+}
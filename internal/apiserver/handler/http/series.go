@@ -0,0 +1,22 @@
+package http
+
+import (
+	"github.com/ArthurWang23/miniblog/pkg/core"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) CreateSeries(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.SeriesV1().Create, h.val.ValidateCreateSeriesRequest)
+}
+
+func (h *Handler) AddPostToSeries(c *gin.Context) {
+	core.HandleUriJSONRequest(c, h.biz.SeriesV1().AddPost, h.val.ValidateAddPostToSeriesRequest)
+}
+
+func (h *Handler) ReorderSeries(c *gin.Context) {
+	core.HandleUriJSONRequest(c, h.biz.SeriesV1().Reorder, h.val.ValidateReorderSeriesRequest)
+}
+
+func (h *Handler) GetSeries(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.SeriesV1().Get, h.val.ValidateGetSeriesRequest)
+}
@@ -0,0 +1,10 @@
+package http
+
+import (
+	"github.com/ArthurWang23/miniblog/pkg/core"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) ListTags(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.TagV1().List)
+}
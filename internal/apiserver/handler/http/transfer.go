@@ -0,0 +1,106 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/core"
+	"github.com/ArthurWang23/miniblog/pkg/errorsx"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// 导入导出的HTTP接口与grpc-gateway处理流式RPC的方式保持一致
+// 导出时每行输出一个{"result": ExportPostsResponse}，导入时请求体是多个连续的ImportPostsRequest JSON对象
+
+// ExportPosts 逐篇输出导出的博文，输出开始后发生的错误以{"error": ...}的形式写在最后一行
+func (h *Handler) ExportPosts(c *gin.Context) {
+	var rq apiv1.ExportPostsRequest
+	if err := core.ShouldBindQuery(c, &rq); err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+	started := false
+	send := func(resp *apiv1.ExportPostsResponse) error {
+		data, err := protojson.Marshal(resp)
+		if err != nil {
+			return err
+		}
+		if !started {
+			started = true
+			c.Header("Content-Type", "application/x-ndjson")
+			c.Status(http.StatusOK)
+		}
+		line, _ := json.Marshal(map[string]json.RawMessage{"result": data})
+		if _, err := c.Writer.Write(append(line, '\n')); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	}
+	err := h.biz.PostV1().Export(c.Request.Context(), &rq, send)
+	switch {
+	case !started:
+		if err == nil {
+			c.Header("Content-Type", "application/x-ndjson")
+			c.Status(http.StatusOK)
+			return
+		}
+		core.WriteResponse(c, nil, err)
+	case err != nil:
+		errx := errorsx.FromError(err)
+		line, _ := json.Marshal(map[string]core.ErrorResponse{"error": {Reason: errx.Reason, Message: errx.Message, Metadata: errx.MetaData}})
+		_, _ = c.Writer.Write(append(line, '\n'))
+	}
+}
+
+// ImportPosts 请求体中每个JSON对象携带一篇待导入的博文，格式和默认作者从第一个对象中读取
+func (h *Handler) ImportPosts(c *gin.Context) {
+	dec := json.NewDecoder(c.Request.Body)
+	read := func() (*apiv1.ImportPostsRequest, error) {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, err
+			}
+			return nil, errno.ErrBind.WithMessage("%s", err.Error())
+		}
+		var rq apiv1.ImportPostsRequest
+		if err := protojson.Unmarshal(raw, &rq); err != nil {
+			return nil, errno.ErrBind.WithMessage("%s", err.Error())
+		}
+		return &rq, nil
+	}
+
+	first, err := read()
+	if errors.Is(err, io.EOF) {
+		core.WriteResponse(c, &apiv1.ImportPostsResponse{}, nil)
+		return
+	}
+	if err == nil {
+		err = h.val.ValidateImportPostsRequest(c.Request.Context(), first)
+	}
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+	pending := first.GetData()
+	next := func() ([]byte, error) {
+		if len(pending) > 0 {
+			data := pending
+			pending = nil
+			return data, nil
+		}
+		rq, err := read()
+		if err != nil {
+			return nil, err
+		}
+		return rq.GetData(), nil
+	}
+	resp, err := h.biz.PostV1().Import(c.Request.Context(), first.GetFormat(), first.GetAuthor(), next)
+	core.WriteResponse(c, resp, err)
+}
@@ -0,0 +1,24 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/pkg/known"
+)
+
+func TestImportPostsValidatesFirstMessage(t *testing.T) {
+	r, _ := newTestRouter(t, &model.UserM{Username: known.AdminUsername})
+
+	w := serve(r, http.MethodPost, "/v1/admin/posts/import", `{"format":"xml"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+
+	w = serve(r, http.MethodPost, "/v1/admin/posts/import", `{"author":"bad name!"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+
+	w = serve(r, http.MethodPost, "/v1/admin/posts/import", `{"format":"jsonl"} not-json`)
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+}
@@ -27,6 +27,11 @@ func (h *Handler) UpdateUser(c *gin.Context) {
 	core.HandleJSONRequest(c, h.biz.UserV1().Update)
 }
 
+// PatchUser 请求体按照protojson解析，通过updateMask指定需要修改的字段
+func (h *Handler) PatchUser(c *gin.Context) {
+	core.HandleUriProtoJSONRequest(c, h.biz.UserV1().Update, h.val.ValidateUpdateUserRequest)
+}
+
 func (h *Handler) DeleteUser(c *gin.Context) {
 	core.HandleUriRequest(c, h.biz.UserV1().Delete)
 }
@@ -36,5 +41,5 @@ func (h *Handler) GetUser(c *gin.Context) {
 }
 
 func (h *Handler) ListUser(c *gin.Context) {
-	core.HandleQueryRequest(c, h.biz.UserV1().List)
+	core.HandleProtoQueryRequest(c, h.biz.UserV1().List, h.val.ValidateListUsersRequest)
 }
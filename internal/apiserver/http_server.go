@@ -11,6 +11,7 @@ import (
 	"net/http"
 
 	handler "github.com/ArthurWang23/miniblog/internal/apiserver/handler/http"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/feed"
 	mw "github.com/ArthurWang23/miniblog/internal/pkg/middleware/gin"
 	"github.com/ArthurWang23/miniblog/internal/pkg/server"
 	"github.com/gin-contrib/pprof"
@@ -23,16 +24,20 @@ type ginServer struct {
 
 var _ server.Server = (*ginServer)(nil)
 
-func (c *ServerConfig) NewGinServer() server.Server {
+func (c *ServerConfig) NewGinServer() (server.Server, error) {
 	engin := gin.New()
+	// 默认信任所有代理，客户端可以通过X-Forwarded-For伪造IP
+	if err := engin.SetTrustedProxies(c.cfg.TrustedProxies); err != nil {
+		return nil, err
+	}
 	// 先注册中间件，再注册路由
-	engin.Use(gin.Recovery(), mw.NoCache, mw.Cors, mw.Secure, mw.RequestIDMiddleware())
+	engin.Use(gin.Recovery(), mw.NoCache, mw.Cors, mw.Secure, mw.RequestIDMiddleware(), mw.ClientIPMiddleware())
 	// 注册rest api 路由
 	c.InstallRESTAPI(engin)
 	httpsrv := server.NewHTTPServer(c.cfg.HTTPOptions, c.cfg.TLSOptions, engin)
 	return &ginServer{
 		srv: httpsrv,
-	}
+	}, nil
 }
 
 func (c *ServerConfig) InstallRESTAPI(engin *gin.Engine) {
@@ -44,6 +49,21 @@ func (c *ServerConfig) InstallRESTAPI(engin *gin.Engine) {
 	// 这两个接口比较简单，没有API版本
 	engin.POST("/login", handler.Login)
 	engin.PUT("/refresh-token", mw.AuthnMiddleware(c.retriever), handler.RefreshToken)
+	// 博文的永久链接，匿名用户也可以访问
+	engin.GET("/u/:username/:slug", handler.GetPostBySlug)
+	// 订阅源，匿名用户也可以访问
+	for _, format := range []feed.Format{feed.RSS, feed.Atom} {
+		engin.GET("/feed."+string(format), func(ctx *gin.Context) {
+			c.serveFeed(ctx.Writer, ctx.Request, "", format)
+		})
+		engin.GET("/u/:username/feed."+string(format), func(ctx *gin.Context) {
+			c.serveFeed(ctx.Writer, ctx.Request, ctx.Param("username"), format)
+		})
+	}
+	// 上传的媒体文件，匿名用户也可以访问
+	engin.GET(mediaURLPrefix+"/:key", func(ctx *gin.Context) {
+		serveMedia(c.cfg.MediaDir, ctx.Writer, ctx.Request, ctx.Param("key"))
+	})
 
 	authMiddlewares := []gin.HandlerFunc{mw.AuthnMiddleware(c.retriever), mw.AuthzMiddleware(c.authz)}
 	v1 := engin.Group("/v1")
@@ -54,17 +74,94 @@ func (c *ServerConfig) InstallRESTAPI(engin *gin.Engine) {
 			userv1.Use(authMiddlewares...)
 			userv1.GET(":userID", handler.GetUser)
 			userv1.PUT(":userID", handler.UpdateUser)
+			userv1.PATCH(":userID", handler.PatchUser)
 			userv1.DELETE(":userID", handler.DeleteUser)
 			userv1.PUT(":userID/change-password", handler.ChangePassword)
 			userv1.GET("", handler.ListUser)
+			userv1.POST(":userID/follow", handler.Follow)
+			userv1.POST(":userID/unfollow", handler.Unfollow)
+			userv1.GET(":userID/followers", handler.ListFollowers)
+			userv1.GET(":userID/following", handler.ListFollowing)
 		}
 		postv1 := v1.Group("/posts", authMiddlewares...)
 		{
 			postv1.POST("", handler.CreatePost)
 			postv1.PUT(":postID", handler.UpdatePost)
+			postv1.PATCH(":postID", handler.PatchPost)
 			postv1.DELETE("", handler.DeletePost)
 			postv1.GET(":postID", handler.GetPost)
 			postv1.GET("", handler.ListPost)
+			postv1.POST(":postID/publish", handler.PublishPost)
+			postv1.POST(":postID/unpublish", handler.UnpublishPost)
+			postv1.POST(":postID/archive", handler.ArchivePost)
+			postv1.POST(":postID/like", handler.LikePost)
+			postv1.POST(":postID/unlike", handler.UnlikePost)
+			postv1.POST(":postID/pin", handler.PinPost)
+			postv1.POST(":postID/unpin", handler.UnpinPost)
+			postv1.GET(":postID/revisions", handler.ListPostRevisions)
+			postv1.GET(":postID/revisions/:revision", handler.GetPostRevision)
+			postv1.POST(":postID/revisions/:revision/restore", handler.RestorePostRevision)
+			postv1.GET(":postID/diff", handler.DiffPostRevisions)
+			postv1.POST(":postID/restore", handler.RestorePost)
+		}
+		mediav1 := v1.Group("/media", authMiddlewares...)
+		{
+			mediav1.POST("", handler.UploadMedia)
+		}
+		trashv1 := v1.Group("/trash", authMiddlewares...)
+		{
+			trashv1.GET("/posts", handler.ListDeletedPosts)
+		}
+		adminv1 := v1.Group("/admin", authMiddlewares...)
+		{
+			adminv1.GET("/posts/export", handler.ExportPosts)
+			adminv1.POST("/posts/import", handler.ImportPosts)
+			adminv1.GET("/moderation/posts", handler.ListModeratedPosts)
+			adminv1.POST("/moderation/posts/:postID/approve", handler.ApproveModeratedPost)
+			adminv1.POST("/moderation/posts/:postID/reject", handler.RejectModeratedPost)
+		}
+		bookmarkv1 := v1.Group("/bookmarks", authMiddlewares...)
+		{
+			bookmarkv1.POST("", handler.AddBookmark)
+			bookmarkv1.GET("", handler.ListBookmarks)
+			bookmarkv1.DELETE(":postID", handler.RemoveBookmark)
+		}
+		timelinev1 := v1.Group("/timeline", authMiddlewares...)
+		{
+			timelinev1.GET("", handler.GetTimeline)
+		}
+		notificationv1 := v1.Group("/notifications", authMiddlewares...)
+		{
+			notificationv1.GET("", handler.ListNotifications)
+			notificationv1.POST("/read", handler.MarkNotificationsRead)
+			notificationv1.GET("/unread-count", handler.GetUnreadNotificationCount)
+		}
+		seriesv1 := v1.Group("/series", authMiddlewares...)
+		{
+			seriesv1.POST("", handler.CreateSeries)
+			seriesv1.GET(":seriesID", handler.GetSeries)
+			seriesv1.POST(":seriesID/posts", handler.AddPostToSeries)
+			seriesv1.PUT(":seriesID/order", handler.ReorderSeries)
+		}
+		commentv1 := v1.Group("/comments", authMiddlewares...)
+		{
+			commentv1.POST("", handler.CreateComment)
+			commentv1.DELETE(":commentID", handler.DeleteComment)
+		}
+		tagv1 := v1.Group("/tags", authMiddlewares...)
+		{
+			tagv1.GET("", handler.ListTags)
+		}
+		searchv1 := v1.Group("/search", authMiddlewares...)
+		{
+			searchv1.GET("/posts", handler.SearchPosts)
+		}
+		// 公开接口，匿名用户也可以浏览已发布的博文，因此不使用认证和授权中间件
+		publicv1 := v1.Group("/public")
+		{
+			publicv1.GET("/posts", handler.ListPublicPosts)
+			publicv1.GET("/posts/:postID", handler.GetPublicPost)
+			publicv1.GET("/comments", handler.ListComments)
 		}
 	}
 
@@ -19,12 +19,40 @@ func (m *UserM) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// 新建博文的版本号从1开始，之后每次通过PostStore.Update修改时加1
+func (m *PostM) BeforeCreate(tx *gorm.DB) error {
+	if m.Version == 0 {
+		m.Version = 1
+	}
+	return nil
+}
+
 // 添加数据库表userID和postID字段的自动生成钩子，用来生成并保存记录的唯一标识符
 func (m *PostM) AfterCreate(tx *gorm.DB) error {
 	m.PostID = rid.PostID.New(uint64(m.ID))
 	return tx.Save(m).Error
 }
 
+func (m *CommentM) AfterCreate(tx *gorm.DB) error {
+	m.CommentID = rid.CommentID.New(uint64(m.ID))
+	return tx.Save(m).Error
+}
+
+func (m *MediaM) AfterCreate(tx *gorm.DB) error {
+	m.MediaID = rid.MediaID.New(uint64(m.ID))
+	return tx.Save(m).Error
+}
+
+func (m *SeriesM) AfterCreate(tx *gorm.DB) error {
+	m.SeriesID = rid.SeriesID.New(uint64(m.ID))
+	return tx.Save(m).Error
+}
+
+func (m *NotificationM) AfterCreate(tx *gorm.DB) error {
+	m.NotificationID = rid.NotificationID.New(uint64(m.ID))
+	return tx.Save(m).Error
+}
+
 func (m *UserM) AfterCreate(tx *gorm.DB) error {
 	m.UserID = rid.UserID.New(uint64(m.ID))
 	return tx.Save(m).Error
@@ -6,19 +6,32 @@ package model
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 const TableNamePostM = "post"
 
 // PostM 博文表
 type PostM struct {
-	ID        int64     `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
-	UserID    string    `gorm:"column:userID;not null;comment:用户唯一 ID" json:"userID"`                                  // 用户唯一 ID
-	PostID    string    `gorm:"column:postID;not null;uniqueIndex:idx_post_postID;comment:博文唯一 ID" json:"postID"`      // 博文唯一 ID
-	Title     string    `gorm:"column:title;not null;comment:博文标题" json:"title"`                                       // 博文标题
-	Content   string    `gorm:"column:content;not null;comment:博文内容" json:"content"`                                   // 博文内容
-	CreatedAt time.Time `gorm:"column:createdAt;not null;default:current_timestamp;comment:博文创建时间" json:"createdAt"`   // 博文创建时间
-	UpdatedAt time.Time `gorm:"column:updatedAt;not null;default:current_timestamp;comment:博文最后修改时间" json:"updatedAt"` // 博文最后修改时间
+	ID               int64          `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
+	UserID           string         `gorm:"column:userID;not null;uniqueIndex:idx_post_userID_externalID,priority:1;comment:用户唯一 ID" json:"userID"`         // 用户唯一 ID
+	PostID           string         `gorm:"column:postID;not null;uniqueIndex:idx_post_postID;comment:博文唯一 ID" json:"postID"`                               // 博文唯一 ID
+	Title            string         `gorm:"column:title;not null;comment:博文标题" json:"title"`                                                                // 博文标题
+	Slug             string         `gorm:"column:slug;not null;comment:博文当前的slug" json:"slug"`                                                             // 博文当前的slug
+	Content          string         `gorm:"column:content;not null;comment:博文内容" json:"content"`                                                            // 博文内容
+	Status           int32          `gorm:"column:status;not null;comment:博文状态：0-草稿，1-已发布，2-已归档，3-等待审核，4-未通过审核" json:"status"`                              // 博文状态：0-草稿，1-已发布，2-已归档，3-等待审核，4-未通过审核
+	Format           int32          `gorm:"column:format;not null;comment:博文内容格式：0-Markdown，1-纯文本，2-HTML" json:"format"`                                    // 博文内容格式：0-Markdown，1-纯文本，2-HTML
+	PublishedAt      *time.Time     `gorm:"column:publishedAt;comment:博文首次发布时间" json:"publishedAt"`                                                         // 博文首次发布时间
+	PublishAt        *time.Time     `gorm:"column:publishAt;index:idx_post_publishAt;comment:定时发布时间" json:"publishAt"`                                      // 定时发布时间
+	CreatedAt        time.Time      `gorm:"column:createdAt;not null;default:current_timestamp;comment:博文创建时间" json:"createdAt"`                            // 博文创建时间
+	UpdatedAt        time.Time      `gorm:"column:updatedAt;not null;default:current_timestamp;comment:博文最后修改时间" json:"updatedAt"`                          // 博文最后修改时间
+	ViewCount        int64          `gorm:"column:viewCount;not null;default:0;<-:create;comment:浏览次数，只通过AddViews累加" json:"viewCount"`                      // 浏览次数
+	Version          int64          `gorm:"column:version;not null;default:1;comment:博文版本号，每次修改加1" json:"version"`                                          // 博文版本号，每次修改加1
+	HeldStatus       int32          `gorm:"column:heldStatus;not null;default:0;comment:进入审核前的博文状态，审核通过后恢复" json:"heldStatus"`                              // 进入审核前的博文状态，审核通过后恢复
+	ModerationReason string         `gorm:"column:moderationReason;not null;default:'';comment:博文进入审核或未通过审核的原因" json:"moderationReason"`                    // 博文进入审核或未通过审核的原因
+	ExternalID       *string        `gorm:"column:externalID;uniqueIndex:idx_post_userID_externalID,priority:2;comment:导入博文在来源系统中的唯一 ID" json:"externalID"` // 导入博文在来源系统中的唯一 ID
+	DeletedAt        gorm.DeletedAt `gorm:"column:deletedAt;index:idx_post_deletedAt;comment:博文删除时间，为空表示未删除" json:"deletedAt"`                              // 博文删除时间，为空表示未删除
 }
 
 // TableName PostM's table name
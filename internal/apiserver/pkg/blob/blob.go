@@ -0,0 +1,25 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotExist 表示对象不存在
+var ErrNotExist = errors.New("blob: object does not exist")
+
+// Store 保存上传文件内容的对象存储，key由调用方决定
+// 目前只有本地文件系统实现，后续可以接入S3、OSS等对象存储
+type Store interface {
+	// Put 保存对象，key已存在时覆盖原有内容
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Open 读取对象，对象不存在时返回ErrNotExist
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Exists 判断对象是否存在
+	Exists(ctx context.Context, key string) (bool, error)
+	// Delete 删除对象，对象不存在时不返回错误
+	Delete(ctx context.Context, key string) error
+	// URL 返回对象的访问地址
+	URL(key string) string
+}
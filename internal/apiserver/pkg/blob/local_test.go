@@ -0,0 +1,44 @@
+package blob
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocal(t *testing.T) {
+	ctx := context.Background()
+	l, err := NewLocal(t.TempDir(), "/media/")
+	require.NoError(t, err)
+
+	require.NoError(t, l.Put(ctx, "abc.png", strings.NewReader("data")))
+	exists, err := l.Exists(ctx, "abc.png")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	rc, err := l.Open(ctx, "abc.png")
+	require.NoError(t, err)
+	data, _ := io.ReadAll(rc)
+	rc.Close()
+	assert.Equal(t, "data", string(data))
+	assert.Equal(t, "/media/abc.png", l.URL("abc.png"))
+
+	require.NoError(t, l.Delete(ctx, "abc.png"))
+	require.NoError(t, l.Delete(ctx, "abc.png"))
+	_, err = l.Open(ctx, "abc.png")
+	assert.ErrorIs(t, err, ErrNotExist)
+}
+
+func TestLocalInvalidKey(t *testing.T) {
+	ctx := context.Background()
+	l, err := NewLocal(t.TempDir(), "/media")
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "../x", "a/b", `a\b`, ".hidden"} {
+		assert.Error(t, l.Put(ctx, key, strings.NewReader("x")), key)
+	}
+}
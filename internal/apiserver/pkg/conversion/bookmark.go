@@ -0,0 +1,21 @@
+package conversion
+
+import (
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// BookmarkModelToBookmarkV1 bookmarkModel.Post不为空时同时转换被收藏的博文
+func BookmarkModelToBookmarkV1(bookmarkModel *model.BookmarkM) *apiv1.Bookmark {
+	protoBookmark := &apiv1.Bookmark{
+		PostID:    bookmarkModel.PostID,
+		Note:      bookmarkModel.Note,
+		CreatedAt: timestamppb.New(bookmarkModel.CreatedAt),
+		UpdatedAt: timestamppb.New(bookmarkModel.UpdatedAt),
+	}
+	if bookmarkModel.Post != nil {
+		protoBookmark.Post = PostModelToPostV1(bookmarkModel.Post)
+	}
+	return protoBookmark
+}
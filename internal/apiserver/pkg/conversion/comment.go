@@ -0,0 +1,13 @@
+package conversion
+
+import (
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/core"
+)
+
+func CommentModelToCommentV1(commentModel *model.CommentM) *apiv1.Comment {
+	var protoComment apiv1.Comment
+	_ = core.CopyWithConverters(&protoComment, commentModel)
+	return &protoComment
+}
@@ -0,0 +1,17 @@
+package conversion
+
+import (
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// UserModelToFollowUserV1 只保留用户可公开的字段，关注时间取自关注关系
+func UserModelToFollowUserV1(userModel *model.UserM, followModel *model.FollowM) *apiv1.FollowUser {
+	return &apiv1.FollowUser{
+		UserID:     userModel.UserID,
+		Username:   userModel.Username,
+		Nickname:   userModel.Nickname,
+		FollowedAt: timestamppb.New(followModel.CreatedAt),
+	}
+}
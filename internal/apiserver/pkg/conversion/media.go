@@ -0,0 +1,14 @@
+package conversion
+
+import (
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/core"
+)
+
+func MediaModelToMediaV1(mediaModel *model.MediaM) *apiv1.Media {
+	var protoMedia apiv1.Media
+	_ = core.CopyWithConverters(&protoMedia, mediaModel)
+	protoMedia.Sha256 = mediaModel.Hash
+	return &protoMedia
+}
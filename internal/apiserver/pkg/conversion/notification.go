@@ -0,0 +1,28 @@
+package conversion
+
+import (
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NotificationModelToNotificationV1 不包含触发者信息，由调用方批量查询后填充
+func NotificationModelToNotificationV1(notificationModel *model.NotificationM) *apiv1.Notification {
+	return &apiv1.Notification{
+		NotificationID: notificationModel.NotificationID,
+		Type:           apiv1.NotificationType(notificationModel.Type),
+		PostID:         notificationModel.PostID,
+		CommentID:      notificationModel.CommentID,
+		Read:           notificationModel.ReadAt != nil,
+		CreatedAt:      timestamppb.New(notificationModel.CreatedAt),
+	}
+}
+
+// UserModelToNotificationActorV1 只保留用户可公开的字段
+func UserModelToNotificationActorV1(userModel *model.UserM) *apiv1.NotificationActor {
+	return &apiv1.NotificationActor{
+		UserID:   userModel.UserID,
+		Username: userModel.Username,
+		Nickname: userModel.Nickname,
+	}
+}
@@ -17,3 +17,18 @@ func PostV1ToPostModel(protoPost *apiv1.Post) *model.PostM {
 	_ = core.CopyWithConverters(&postModel, protoPost)
 	return &postModel
 }
+
+// UserModelToPostAuthorV1 只保留用户可公开的字段作为博文作者信息
+func UserModelToPostAuthorV1(userModel *model.UserM) *apiv1.PostAuthor {
+	return &apiv1.PostAuthor{
+		UserID:   userModel.UserID,
+		Username: userModel.Username,
+		Nickname: userModel.Nickname,
+	}
+}
+
+func PostRevisionModelToPostRevisionV1(revisionModel *model.PostRevisionM) *apiv1.PostRevision {
+	var protoRevision apiv1.PostRevision
+	_ = core.CopyWithConverters(&protoRevision, revisionModel)
+	return &protoRevision
+}
@@ -0,0 +1,32 @@
+package conversion
+
+import (
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/core"
+)
+
+func SeriesModelToSeriesV1(seriesModel *model.SeriesM) *apiv1.Series {
+	var protoSeries apiv1.Series
+	_ = core.CopyWithConverters(&protoSeries, seriesModel)
+	return &protoSeries
+}
+
+// PostModelToSeriesPostV1 position是博文在系列中的位置，从1开始
+func PostModelToSeriesPostV1(postModel *model.PostM, position int64) *apiv1.SeriesPost {
+	return &apiv1.SeriesPost{
+		PostID:   postModel.PostID,
+		Title:    postModel.Title,
+		Slug:     postModel.Slug,
+		Status:   apiv1.PostStatus(postModel.Status),
+		Position: position,
+	}
+}
+
+func PostModelToPostLinkV1(postModel *model.PostM) *apiv1.PostLink {
+	return &apiv1.PostLink{
+		PostID: postModel.PostID,
+		Title:  postModel.Title,
+		Slug:   postModel.Slug,
+	}
+}
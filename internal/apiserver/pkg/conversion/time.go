@@ -0,0 +1,17 @@
+package conversion
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TimestampToTime 将请求中可选的时间转换为本地时区的时间，未设置时返回nil
+func TimestampToTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	// 与其他时间字段一样使用本地时区保存和比较
+	t := ts.AsTime().Local()
+	return &t
+}
@@ -0,0 +1,33 @@
+// Package etag 实现博文版本号与HTTP ETag之间的转换，用于乐观并发控制
+package etag
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+)
+
+// Format 将版本号格式化为强ETag，例如 "3"
+func Format(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// ParseIfMatch 解析If-Match请求头，返回客户端期望的版本号
+// 请求头为空或为*时返回nil，表示不校验版本
+// If-Match使用强比较，弱ETag永远不会匹配，因此不接受弱ETag
+func ParseIfMatch(header string) (*int64, error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+	value, err := strconv.Unquote(header)
+	if err != nil {
+		return nil, errno.ErrInvalidArgument.WithMessage("If-Match must be a single strong ETag")
+	}
+	version, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || version <= 0 {
+		return nil, errno.ErrInvalidArgument.WithMessage("If-Match must be a single strong ETag")
+	}
+	return &version, nil
+}
@@ -0,0 +1,28 @@
+package etag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	assert.Equal(t, `"3"`, Format(3))
+}
+
+func TestParseIfMatch(t *testing.T) {
+	version, err := ParseIfMatch(Format(42))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), *version)
+
+	for _, header := range []string{"", "*", "  "} {
+		version, err := ParseIfMatch(header)
+		assert.NoError(t, err)
+		assert.Nil(t, version)
+	}
+
+	for _, header := range []string{`W/"3"`, "3", `"abc"`, `"0"`, `"1", "2"`} {
+		_, err := ParseIfMatch(header)
+		assert.Error(t, err, header)
+	}
+}
@@ -0,0 +1,87 @@
+// Package event 定义业务模块之间传递的内部事件
+// 生产者只通过Publisher发布事件，不依赖事件的消费者，目前由Bus在进程内同步分发，
+// 需要异步处理或跨进程消费时可以换成基于消息队列的Publisher实现
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/ArthurWang23/miniblog/internal/pkg/log"
+)
+
+// Type 事件类型
+type Type string
+
+const (
+	// PostLiked 博文被点赞
+	PostLiked Type = "post.liked"
+	// PostCommented 博文收到评论
+	PostCommented Type = "post.commented"
+	// CommentReplied 评论收到回复
+	CommentReplied Type = "comment.replied"
+	// UserFollowed 用户被关注
+	UserFollowed Type = "user.followed"
+)
+
+// Event 描述一次用户之间的互动
+type Event struct {
+	Type Type
+	// 触发事件的用户
+	ActorID string
+	// 被操作资源的所有者，例如博文作者、被回复评论的作者或被关注的用户
+	OwnerID   string
+	PostID    string
+	CommentID string
+	// 为空时在发布时设置为当前时间
+	OccurredAt time.Time
+}
+
+// Publisher 发布事件，事件处理失败不影响生产者的业务，只记录日志
+type Publisher interface {
+	Publish(ctx context.Context, events ...Event)
+}
+
+// Handler 处理事件
+type Handler interface {
+	Handle(ctx context.Context, e Event) error
+}
+
+// HandlerFunc 将函数适配为Handler
+type HandlerFunc func(ctx context.Context, e Event) error
+
+func (f HandlerFunc) Handle(ctx context.Context, e Event) error {
+	return f(ctx, e)
+}
+
+// Bus 在发布事件的协程中依次调用所有订阅者，nil Bus不分发任何事件
+type Bus struct {
+	handlers []Handler
+}
+
+var _ Publisher = (*Bus)(nil)
+
+func NewBus(handlers ...Handler) *Bus {
+	return &Bus{handlers: handlers}
+}
+
+// Subscribe 需要在发布事件之前调用，Bus不支持并发订阅
+func (b *Bus) Subscribe(handler Handler) {
+	b.handlers = append(b.handlers, handler)
+}
+
+func (b *Bus) Publish(ctx context.Context, events ...Event) {
+	if b == nil {
+		return
+	}
+	for _, e := range events {
+		if e.OccurredAt.IsZero() {
+			e.OccurredAt = time.Now()
+		}
+		for _, handler := range b.handlers {
+			if err := handler.Handle(ctx, e); err != nil {
+				log.W(ctx).Errorw("Failed to handle event", "type", e.Type, "actor", e.ActorID, "owner", e.OwnerID, "err", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBus(t *testing.T) {
+	var got []Event
+	bus := NewBus(HandlerFunc(func(ctx context.Context, e Event) error {
+		return errors.New("failed")
+	}))
+	bus.Subscribe(HandlerFunc(func(ctx context.Context, e Event) error {
+		got = append(got, e)
+		return nil
+	}))
+
+	bus.Publish(context.Background(), Event{Type: PostLiked, ActorID: "a"}, Event{Type: UserFollowed, ActorID: "b"})
+	assert.Len(t, got, 2)
+	assert.Equal(t, PostLiked, got[0].Type)
+	assert.Equal(t, UserFollowed, got[1].Type)
+	assert.False(t, got[0].OccurredAt.IsZero())
+
+	var nilBus *Bus
+	nilBus.Publish(context.Background(), Event{Type: PostLiked})
+}
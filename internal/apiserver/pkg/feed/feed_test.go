@@ -0,0 +1,84 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testFeed() *Feed {
+	published := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	return &Feed{
+		Title:   "alice - miniblog",
+		Link:    "https://blog.example/u/alice",
+		Self:    "https://blog.example/u/alice/feed.rss",
+		Updated: published.Add(time.Hour),
+		Items: []Item{{
+			ID:         "https://blog.example/v1/public/posts/post-abc",
+			Title:      "Tom & Jerry",
+			Link:       "https://blog.example/u/alice/tom-jerry",
+			Author:     "Alice",
+			Categories: []string{"go"},
+			Content:    "<p>a ]]> b</p>",
+			Published:  published,
+			Updated:    published.Add(time.Hour),
+		}},
+	}
+}
+
+func TestWriteRSS(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, Write(&buf, testFeed(), RSS))
+
+	var doc struct {
+		Channel struct {
+			Title string `xml:"title"`
+			Items []struct {
+				Title       string `xml:"title"`
+				GUID        string `xml:"guid"`
+				PubDate     string `xml:"pubDate"`
+				Description string `xml:"description"`
+				Creator     string `xml:"http://purl.org/dc/elements/1.1/ creator"`
+			} `xml:"item"`
+		} `xml:"channel"`
+	}
+	assert.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+	assert.Equal(t, "alice - miniblog", doc.Channel.Title)
+	assert.Len(t, doc.Channel.Items, 1)
+	item := doc.Channel.Items[0]
+	assert.Equal(t, "Tom & Jerry", item.Title)
+	assert.Equal(t, "https://blog.example/v1/public/posts/post-abc", item.GUID)
+	assert.Equal(t, "Thu, 02 Jan 2025 03:04:05 +0000", item.PubDate)
+	assert.Equal(t, "<p>a ]]> b</p>", item.Description)
+	assert.Equal(t, "Alice", item.Creator)
+}
+
+func TestWriteAtom(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, Write(&buf, testFeed(), Atom))
+
+	var doc struct {
+		XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+		Updated string   `xml:"updated"`
+		Entries []struct {
+			ID      string `xml:"id"`
+			Content struct {
+				Type  string `xml:"type,attr"`
+				Value string `xml:",chardata"`
+			} `xml:"content"`
+			Author struct {
+				Name string `xml:"name"`
+			} `xml:"author"`
+		} `xml:"entry"`
+	}
+	assert.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+	assert.Equal(t, "2025-01-02T04:04:05Z", doc.Updated)
+	assert.Len(t, doc.Entries, 1)
+	assert.Equal(t, "https://blog.example/v1/public/posts/post-abc", doc.Entries[0].ID)
+	assert.Equal(t, "html", doc.Entries[0].Content.Type)
+	assert.Equal(t, "<p>a ]]> b</p>", doc.Entries[0].Content.Value)
+	assert.Equal(t, "Alice", doc.Entries[0].Author.Name)
+}
@@ -0,0 +1,60 @@
+// Package fieldmask 解析更新请求中的google.protobuf.FieldMask，得到本次需要修改的字段
+package fieldmask
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Wildcard 表示全部允许修改的字段
+const Wildcard = "*"
+
+// Paths 本次需要修改的字段集合，字段名与proto中的字段名一致
+type Paths map[string]struct{}
+
+// Parse 校验mask中的字段是否都在allowed中，返回规范化后的字段集合
+// 网关使用protojson解析请求时，会将JSON中的驼峰字段名转换为下划线形式（publishAt -> publish_at），
+// 这里统一转换回proto中的驼峰字段名
+func Parse(mask *fieldmaskpb.FieldMask, allowed ...string) (Paths, error) {
+	if len(mask.GetPaths()) == 0 {
+		return nil, errno.ErrInvalidArgument.WithMessage("updateMask cannot be empty")
+	}
+	paths := make(Paths, len(mask.GetPaths()))
+	for _, path := range mask.GetPaths() {
+		if path == Wildcard {
+			paths.Add(allowed...)
+			continue
+		}
+		path = Normalize(path)
+		if !slices.Contains(allowed, path) {
+			return nil, errno.ErrInvalidArgument.WithMessage("field %q cannot be updated, allowed fields are %s", path, strings.Join(allowed, ", "))
+		}
+		paths.Add(path)
+	}
+	return paths, nil
+}
+
+func (p Paths) Add(paths ...string) {
+	for _, path := range paths {
+		p[path] = struct{}{}
+	}
+}
+
+func (p Paths) Has(path string) bool {
+	_, ok := p[path]
+	return ok
+}
+
+// Normalize 将下划线形式的字段名转换为proto中的驼峰字段名，例如 publish_at -> publishAt
+func Normalize(path string) string {
+	parts := strings.Split(path, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,28 @@
+package fieldmask
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestParse(t *testing.T) {
+	allowed := []string{"title", "content", "publishAt"}
+
+	paths, err := Parse(&fieldmaskpb.FieldMask{Paths: []string{"title", "publish_at"}}, allowed...)
+	assert.NoError(t, err)
+	assert.True(t, paths.Has("title"))
+	assert.True(t, paths.Has("publishAt"))
+	assert.False(t, paths.Has("content"))
+
+	paths, err = Parse(&fieldmaskpb.FieldMask{Paths: []string{"*"}}, allowed...)
+	assert.NoError(t, err)
+	assert.Len(t, paths, 3)
+
+	_, err = Parse(&fieldmaskpb.FieldMask{Paths: []string{"userID"}}, allowed...)
+	assert.Error(t, err)
+
+	_, err = Parse(&fieldmaskpb.FieldMask{}, allowed...)
+	assert.Error(t, err)
+}
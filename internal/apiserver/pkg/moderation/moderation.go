@@ -0,0 +1,124 @@
+// Package moderation 实现博文的内容审核
+// 审核由一组规则组成，每条规则给出允许、等待人工审核或拒绝的结论，最终结果取最严格的结论
+package moderation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Verdict 审核结论，值越大越严格
+type Verdict int
+
+const (
+	// Allow 允许保存
+	Allow Verdict = iota
+	// Hold 允许保存，但需要管理员审核通过后才能对外可见
+	Hold
+	// Reject 拒绝保存
+	Reject
+)
+
+var verdictNames = map[Verdict]string{Allow: "allow", Hold: "hold", Reject: "reject"}
+
+func (v Verdict) String() string {
+	return verdictNames[v]
+}
+
+// ParseVerdict 解析配置中的审核结论
+func ParseVerdict(s string) (Verdict, error) {
+	for verdict, name := range verdictNames {
+		if strings.EqualFold(s, name) {
+			return verdict, nil
+		}
+	}
+	return Allow, fmt.Errorf("invalid moderation action %q, must be one of allow, hold, reject", s)
+}
+
+// Result 审核结果，Reason说明命中的规则，结论为Allow时为空
+type Result struct {
+	Verdict Verdict
+	Reason  string
+}
+
+// Rule 审核规则，对博文的标题和内容给出审核结果
+type Rule interface {
+	Check(title, content string) Result
+}
+
+// RuleFunc 将函数转换为审核规则
+type RuleFunc func(title, content string) Result
+
+func (f RuleFunc) Check(title, content string) Result {
+	return f(title, content)
+}
+
+// Moderator 依次执行所有审核规则，nil表示不审核
+type Moderator struct {
+	rules []Rule
+}
+
+func New(rules ...Rule) *Moderator {
+	return &Moderator{rules: rules}
+}
+
+// Check 返回最严格的审核结果，遇到拒绝时不再执行后续规则
+func (m *Moderator) Check(title, content string) Result {
+	var result Result
+	if m == nil {
+		return result
+	}
+	for _, rule := range m.rules {
+		r := rule.Check(title, content)
+		if r.Verdict > result.Verdict {
+			result = r
+		}
+		if result.Verdict == Reject {
+			break
+		}
+	}
+	return result
+}
+
+// BannedWords 标题或内容包含任意禁用词时返回verdict，匹配时不区分大小写
+func BannedWords(words []string, verdict Verdict) Rule {
+	lowered := make([]string, 0, len(words))
+	for _, word := range words {
+		if word = strings.TrimSpace(word); word != "" {
+			lowered = append(lowered, strings.ToLower(word))
+		}
+	}
+	return RuleFunc(func(title, content string) Result {
+		text := strings.ToLower(title + "\n" + content)
+		for _, word := range lowered {
+			if strings.Contains(text, word) {
+				return Result{Verdict: verdict, Reason: fmt.Sprintf("contains banned word %q", word)}
+			}
+		}
+		return Result{}
+	})
+}
+
+var linkPattern = regexp.MustCompile(`(?i)\bhttps?://`)
+
+// LinkLimit 标题和内容中的链接数超过max时返回verdict
+func LinkLimit(max int, verdict Verdict) Rule {
+	return RuleFunc(func(title, content string) Result {
+		count := len(linkPattern.FindAllStringIndex(title+"\n"+content, -1))
+		if count > max {
+			return Result{Verdict: verdict, Reason: fmt.Sprintf("contains %d links, at most %d are allowed", count, max)}
+		}
+		return Result{}
+	})
+}
+
+// Pattern 标题或内容匹配正则表达式时返回verdict，name用于说明命中的规则
+func Pattern(name string, re *regexp.Regexp, verdict Verdict) Rule {
+	return RuleFunc(func(title, content string) Result {
+		if re.MatchString(title) || re.MatchString(content) {
+			return Result{Verdict: verdict, Reason: fmt.Sprintf("matches rule %q", name)}
+		}
+		return Result{}
+	})
+}
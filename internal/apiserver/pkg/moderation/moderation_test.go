@@ -0,0 +1,44 @@
+package moderation
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModerator(t *testing.T) {
+	m := New(
+		BannedWords([]string{"Casino"}, Reject),
+		LinkLimit(2, Hold),
+		Pattern("phone", regexp.MustCompile(`\d{11}`), Hold),
+	)
+
+	assert.Equal(t, Allow, m.Check("hello", "see https://go.dev").Verdict)
+	assert.Equal(t, Hold, m.Check("hello", "http://a http://b https://c").Verdict)
+	assert.Equal(t, Hold, m.Check("call 13800000000", "").Verdict)
+
+	result := m.Check("best casino", "http://a http://b https://c")
+	assert.Equal(t, Reject, result.Verdict)
+	assert.Equal(t, `contains banned word "casino"`, result.Reason)
+
+	var nilModerator *Moderator
+	assert.Equal(t, Allow, nilModerator.Check("casino", "").Verdict)
+}
+
+func TestOptions(t *testing.T) {
+	opts := NewOptions()
+	opts.Patterns = []PatternOptions{{Name: "bad", Pattern: "(", Action: "hold"}}
+	assert.Len(t, opts.Validate(), 1)
+
+	opts.Patterns = []PatternOptions{{Name: "spam", Pattern: "(?i)buy now", Action: "reject"}}
+	opts.BannedWords = []string{"casino"}
+	opts.BannedWordAction = "review"
+	assert.Len(t, opts.Validate(), 1)
+
+	opts.BannedWordAction = "hold"
+	m, err := opts.NewModerator()
+	assert.NoError(t, err)
+	assert.Equal(t, Reject, m.Check("BUY NOW", "").Verdict)
+	assert.Equal(t, Hold, m.Check("casino", "").Verdict)
+}
@@ -0,0 +1,93 @@
+package moderation
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/spf13/pflag"
+)
+
+// Options 内容审核的配置，正则规则只能通过配置文件设置
+type Options struct {
+	// BannedWords 禁用词列表
+	BannedWords []string `json:"banned-words" mapstructure:"banned-words"`
+	// BannedWordAction 命中禁用词时的处理方式
+	BannedWordAction string `json:"banned-word-action" mapstructure:"banned-word-action"`
+	// MaxLinks 单篇博文中允许的最多链接数，小于0表示不限制
+	MaxLinks int `json:"max-links" mapstructure:"max-links"`
+	// MaxLinksAction 链接数超过限制时的处理方式
+	MaxLinksAction string `json:"max-links-action" mapstructure:"max-links-action"`
+	// Patterns 正则规则，按顺序执行
+	Patterns []PatternOptions `json:"patterns" mapstructure:"patterns"`
+}
+
+// PatternOptions 一条正则规则
+type PatternOptions struct {
+	Name    string `json:"name" mapstructure:"name"`
+	Pattern string `json:"pattern" mapstructure:"pattern"`
+	Action  string `json:"action" mapstructure:"action"`
+}
+
+func NewOptions() *Options {
+	return &Options{
+		BannedWordAction: Reject.String(),
+		MaxLinks:         -1,
+		MaxLinksAction:   Hold.String(),
+	}
+}
+
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVar(&o.BannedWords, "moderation.banned-words", o.BannedWords, "Words that are not allowed in posts.")
+	fs.StringVar(&o.BannedWordAction, "moderation.banned-word-action", o.BannedWordAction, "Action for posts containing banned words: allow, hold or reject.")
+	fs.IntVar(&o.MaxLinks, "moderation.max-links", o.MaxLinks, "The maximum number of links in a post, negative means unlimited.")
+	fs.StringVar(&o.MaxLinksAction, "moderation.max-links-action", o.MaxLinksAction, "Action for posts with too many links: allow, hold or reject.")
+}
+
+func (o *Options) Validate() []error {
+	if o == nil {
+		return nil
+	}
+	_, err := o.NewModerator()
+	if err != nil {
+		return []error{err}
+	}
+	return nil
+}
+
+// NewModerator 根据配置创建审核器
+func (o *Options) NewModerator() (*Moderator, error) {
+	if o == nil {
+		return nil, nil
+	}
+	var rules []Rule
+	if len(o.BannedWords) > 0 {
+		verdict, err := ParseVerdict(o.BannedWordAction)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, BannedWords(o.BannedWords, verdict))
+	}
+	if o.MaxLinks >= 0 {
+		verdict, err := ParseVerdict(o.MaxLinksAction)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, LinkLimit(o.MaxLinks, verdict))
+	}
+	for _, p := range o.Patterns {
+		if p.Name == "" {
+			return nil, errors.New("moderation pattern name cannot be empty")
+		}
+		verdict, err := ParseVerdict(p.Action)
+		if err != nil {
+			return nil, err
+		}
+		re, err := regexp.Compile(p.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid moderation pattern %q: %w", p.Name, err)
+		}
+		rules = append(rules, Pattern(p.Name, re, verdict))
+	}
+	return New(rules...), nil
+}
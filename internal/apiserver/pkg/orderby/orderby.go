@@ -0,0 +1,98 @@
+// Package orderby 解析列表接口的排序表达式
+// 每种资源通过Allowlist声明允许排序的字段，列名只来自Allowlist，调用方的输入不会拼接到SQL中
+package orderby
+
+import (
+	"strings"
+
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	"gorm.io/gorm/clause"
+)
+
+// Field 描述一个允许排序的字段
+type Field[T any] struct {
+	// 数据库中的列名
+	Column string
+	// 从记录中取出该字段的值，用于生成游标分页的翻页令牌
+	Value func(*T) any
+}
+
+// Allowlist 资源允许排序的字段，键为API中使用的字段名
+type Allowlist[T any] map[string]Field[T]
+
+// Term 排序表达式中的一项
+type Term[T any] struct {
+	Name string
+	Desc bool
+	Field[T]
+}
+
+// Terms 解析后的排序表达式
+type Terms[T any] []Term[T]
+
+// Parse 解析形如 "createdAt desc, title asc" 的排序表达式，省略方向时按升序排列
+func (a Allowlist[T]) Parse(s string) (Terms[T], error) {
+	var terms Terms[T]
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ",") {
+		words := strings.Fields(part)
+		if len(words) == 0 {
+			continue
+		}
+		field, ok := a[words[0]]
+		if !ok || len(words) > 2 {
+			return nil, errno.ErrInvalidArgument.WithMessage("invalid orderBy: %q", strings.TrimSpace(part))
+		}
+		if seen[words[0]] {
+			return nil, errno.ErrInvalidArgument.WithMessage("duplicate orderBy field: %s", words[0])
+		}
+		seen[words[0]] = true
+
+		term := Term[T]{Name: words[0], Field: field}
+		if len(words) == 2 {
+			switch strings.ToLower(words[1]) {
+			case "asc":
+			case "desc":
+				term.Desc = true
+			default:
+				return nil, errno.ErrInvalidArgument.WithMessage("invalid orderBy direction: %s", words[1])
+			}
+		}
+		terms = append(terms, term)
+	}
+	return terms, nil
+}
+
+// String 返回规范化的排序表达式，同一排序方式的不同写法返回相同的结果
+func (t Terms[T]) String() string {
+	parts := make([]string, 0, len(t))
+	for _, term := range t {
+		if term.Desc {
+			parts = append(parts, term.Name+" desc")
+		} else {
+			parts = append(parts, term.Name+" asc")
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// Columns 返回where.Options.S使用的排序字段
+func (t Terms[T]) Columns() []clause.OrderByColumn {
+	columns := make([]clause.OrderByColumn, 0, len(t))
+	for _, term := range t {
+		columns = append(columns, clause.OrderByColumn{
+			Column: clause.Column{Table: clause.CurrentTable, Name: term.Column},
+			Desc:   term.Desc,
+		})
+	}
+	return columns
+}
+
+// Values 返回记录中各排序字段的值
+func (t Terms[T]) Values(obj *T) []any {
+	values := make([]any, 0, len(t))
+	for _, term := range t {
+		values = append(values, term.Value(obj))
+	}
+	return values
+}
@@ -0,0 +1,51 @@
+package orderby
+
+import (
+	"testing"
+)
+
+type item struct {
+	Title string
+}
+
+var allowlist = Allowlist[item]{
+	"title":     {Column: "title", Value: func(i *item) any { return i.Title }},
+	"createdAt": {Column: "createdAt", Value: func(i *item) any { return "" }},
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"", ""},
+		{"title", "title asc"},
+		{" createdAt DESC ,title asc", "createdAt desc,title asc"},
+	}
+	for _, tt := range tests {
+		terms, err := allowlist.Parse(tt.input)
+		if err != nil {
+			t.Errorf("Parse(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got := terms.String(); got != tt.want {
+			t.Errorf("Parse(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+
+	for _, input := range []string{"content", "title up", "title asc desc", "title, title desc", "id; drop table post"} {
+		if _, err := allowlist.Parse(input); err == nil {
+			t.Errorf("Parse(%q) should fail", input)
+		}
+	}
+}
+
+func TestValues(t *testing.T) {
+	terms, _ := allowlist.Parse("title desc")
+	if values := terms.Values(&item{Title: "t"}); len(values) != 1 || values[0] != "t" {
+		t.Errorf("Values() = %v", values)
+	}
+	if columns := terms.Columns(); len(columns) != 1 || columns[0].Column.Name != "title" || !columns[0].Desc {
+		t.Errorf("Columns() = %v", columns)
+	}
+}
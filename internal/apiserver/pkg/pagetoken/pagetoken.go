@@ -0,0 +1,93 @@
+// Package pagetoken 实现列表接口的游标分页
+// 翻页令牌对客户端不透明，内容为上一页最后一条记录的排序键经过JSON序列化后的base64编码
+package pagetoken
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/orderby"
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+)
+
+// Token 记录上一页最后一条记录的位置
+type Token struct {
+	ID int64 `json:"id"`
+	// 生成令牌时的排序方式，翻页时排序方式必须保持不变
+	OrderBy string `json:"orderBy,omitempty"`
+	// 最后一条记录中各排序字段的值
+	Values []json.RawMessage `json:"values,omitempty"`
+}
+
+func Encode(token Token) string {
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func Decode(s string) (Token, error) {
+	var token Token
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || json.Unmarshal(data, &token) != nil || token.ID <= 0 {
+		return Token{}, errInvalidToken()
+	}
+	return token, nil
+}
+
+// Paginate 按orders为whr设置排序，根据pageToken设置游标条件，并多查询一条记录用于判断是否存在下一页
+// limit小于等于0时不分页，也不会生成下一页的令牌
+func Paginate[T any](whr *where.Options, pageToken string, limit int64, orders orderby.Terms[T]) error {
+	whr.S(orders.Columns()...)
+	if pageToken != "" {
+		token, err := Decode(pageToken)
+		if err != nil {
+			return err
+		}
+		values, err := decodeValues(token, orders)
+		if err != nil {
+			return err
+		}
+		whr.K(token.ID, values...)
+	}
+	if limit > 0 {
+		whr.L(int(limit) + 1)
+	}
+	return nil
+}
+
+// NextPage 去掉Paginate多查询的一条记录，存在下一页时返回下一页的令牌
+func NextPage[T any](list []*T, limit int64, id func(*T) int64, orders orderby.Terms[T]) ([]*T, string) {
+	if limit <= 0 || int64(len(list)) <= limit {
+		return list, ""
+	}
+	list = list[:limit]
+	last := list[len(list)-1]
+
+	token := Token{ID: id(last), OrderBy: orders.String()}
+	for _, value := range orders.Values(last) {
+		data, _ := json.Marshal(value)
+		token.Values = append(token.Values, data)
+	}
+	return list, Encode(token)
+}
+
+// decodeValues 按排序字段的类型解析令牌中的值，排序方式与生成令牌时不同则令牌无效
+func decodeValues[T any](token Token, orders orderby.Terms[T]) ([]any, error) {
+	if token.OrderBy != orders.String() || len(token.Values) != len(orders) {
+		return nil, errInvalidToken()
+	}
+	values := make([]any, 0, len(orders))
+	for i, term := range orders {
+		value := reflect.New(reflect.TypeOf(term.Value(new(T))))
+		if err := json.Unmarshal(token.Values[i], value.Interface()); err != nil {
+			return nil, errInvalidToken()
+		}
+		values = append(values, value.Elem().Interface())
+	}
+	return values, nil
+}
+
+func errInvalidToken() error {
+	return errno.ErrInvalidArgument.WithMessage("invalid page token")
+}
@@ -0,0 +1,63 @@
+package pagetoken
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/orderby"
+)
+
+type row struct {
+	id        int64
+	createdAt time.Time
+}
+
+var allowlist = orderby.Allowlist[row]{
+	"createdAt": {Column: "createdAt", Value: func(r *row) any { return r.createdAt }},
+}
+
+func id(r *row) int64 { return r.id }
+
+func TestEncodeDecode(t *testing.T) {
+	token, err := Decode(Encode(Token{ID: 42}))
+	if err != nil || token.ID != 42 {
+		t.Fatalf("Decode(Encode(42)) = %v, %v", token, err)
+	}
+	for _, s := range []string{"not base64!", "bnVsbA", Encode(Token{})} {
+		if _, err := Decode(s); err == nil {
+			t.Errorf("Decode(%q) should fail", s)
+		}
+	}
+}
+
+func TestNextPage(t *testing.T) {
+	rows := []*row{{id: 5}, {id: 4}, {id: 3}}
+
+	list, next := NextPage(rows, 2, id, nil)
+	if len(list) != 2 || next != Encode(Token{ID: 4}) {
+		t.Errorf("NextPage(3 rows, 2) = %d rows, %q", len(list), next)
+	}
+	if list, next := NextPage(rows, 3, id, nil); len(list) != 3 || next != "" {
+		t.Errorf("NextPage(3 rows, 3) = %d rows, %q", len(list), next)
+	}
+	if list, next := NextPage(rows, 0, id, nil); len(list) != 3 || next != "" {
+		t.Errorf("NextPage(3 rows, 0) = %d rows, %q", len(list), next)
+	}
+}
+
+func TestDecodeValues(t *testing.T) {
+	orders, _ := allowlist.Parse("createdAt desc")
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 6, time.UTC)
+	_, next := NextPage([]*row{{id: 2, createdAt: createdAt}, {id: 1}}, 1, id, orders)
+
+	token, _ := Decode(next)
+	values, err := decodeValues(token, orders)
+	if err != nil || len(values) != 1 || !values[0].(time.Time).Equal(createdAt) {
+		t.Errorf("decodeValues() = %v, %v", values, err)
+	}
+	// 翻页时修改排序方式，令牌失效
+	asc, _ := allowlist.Parse("createdAt asc")
+	if _, err := decodeValues(token, asc); err == nil {
+		t.Error("decodeValues() with different orderBy should fail")
+	}
+}
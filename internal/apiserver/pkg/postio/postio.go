@@ -0,0 +1,196 @@
+package postio
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"gopkg.in/yaml.v3"
+)
+
+// 博文导入导出使用的文件格式
+// JSONL 每行一个JSON对象，对应一篇博文
+// Markdown 每个文件对应一篇博文，文件开头是YAML格式的front matter，之后是博文内容
+
+// Format 导入导出的文件格式
+type Format string
+
+const (
+	JSONL    Format = "jsonl"
+	Markdown Format = "markdown"
+)
+
+// ParseFormat 解析文件格式，为空时使用JSONL
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case "":
+		return JSONL, nil
+	case JSONL, Markdown:
+		return f, nil
+	}
+	return "", fmt.Errorf("unsupported format %q, must be one of %s, %s", s, JSONL, Markdown)
+}
+
+const (
+	// 外部ID的最大字符数
+	maxExternalIDLength = 64
+	// 单篇博文最多可以设置的标签数
+	maxTags = 10
+	// 标签名称的最大字符数
+	maxTagLength = 32
+)
+
+// Record 导入导出的一篇博文
+// ExternalID 是博文在来源系统中的唯一标识，重复导入同一个ExternalID时更新已有的博文
+type Record struct {
+	ExternalID  string     `json:"externalID" yaml:"externalID"`
+	Author      string     `json:"author,omitempty" yaml:"author,omitempty"`
+	Title       string     `json:"title" yaml:"title"`
+	Format      string     `json:"format,omitempty" yaml:"format,omitempty"`
+	Status      string     `json:"status,omitempty" yaml:"status,omitempty"`
+	Tags        []string   `json:"tags,omitempty" yaml:"tags,omitempty"`
+	PublishedAt *time.Time `json:"publishedAt,omitempty" yaml:"publishedAt,omitempty"`
+	CreatedAt   *time.Time `json:"createdAt,omitempty" yaml:"createdAt,omitempty"`
+	// Markdown格式中博文内容位于front matter之后
+	Content string `json:"content" yaml:"-"`
+}
+
+// Validate 检查导入的博文是否合法
+func (r *Record) Validate() error {
+	if r.ExternalID == "" {
+		return errors.New("externalID cannot be empty")
+	}
+	if utf8.RuneCountInString(r.ExternalID) > maxExternalIDLength {
+		return fmt.Errorf("externalID cannot be longer than %d characters", maxExternalIDLength)
+	}
+	if r.Author == "" {
+		return errors.New("author cannot be empty")
+	}
+	if r.Title == "" {
+		return errors.New("title cannot be empty")
+	}
+	if r.Content == "" {
+		return errors.New("content cannot be empty")
+	}
+	if _, err := r.PostFormat(); err != nil {
+		return err
+	}
+	if _, err := r.PostStatus(); err != nil {
+		return err
+	}
+	if len(r.Tags) > maxTags {
+		return fmt.Errorf("a post can have at most %d tags", maxTags)
+	}
+	for _, tag := range r.Tags {
+		if strings.TrimSpace(tag) == "" {
+			return errors.New("tag cannot be empty")
+		}
+		if utf8.RuneCountInString(tag) > maxTagLength {
+			return fmt.Errorf("tag cannot be longer than %d characters", maxTagLength)
+		}
+	}
+	return nil
+}
+
+// PostFormat 返回博文内容格式，为空时使用Markdown
+func (r *Record) PostFormat() (apiv1.PostFormat, error) {
+	if r.Format == "" {
+		return apiv1.PostFormat_Markdown, nil
+	}
+	for value, name := range apiv1.PostFormat_name {
+		if strings.EqualFold(name, r.Format) {
+			return apiv1.PostFormat(value), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid post format %q", r.Format)
+}
+
+// PostStatus 返回博文状态，为空时为草稿
+func (r *Record) PostStatus() (apiv1.PostStatus, error) {
+	if r.Status == "" {
+		return apiv1.PostStatus_Draft, nil
+	}
+	for value, name := range apiv1.PostStatus_name {
+		if strings.EqualFold(name, r.Status) {
+			return apiv1.PostStatus(value), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid post status %q", r.Status)
+}
+
+// FromPost 将博文转换为导出记录，post需要包含作者和标签信息
+func FromPost(post *apiv1.Post, externalID string) *Record {
+	r := &Record{
+		ExternalID: externalID,
+		Author:     post.GetAuthor().GetUsername(),
+		Title:      post.GetTitle(),
+		Format:     strings.ToLower(post.GetFormat().String()),
+		Status:     strings.ToLower(post.GetStatus().String()),
+		Tags:       post.GetTags(),
+		Content:    post.GetContent(),
+	}
+	if post.GetPublishedAt() != nil {
+		publishedAt := post.GetPublishedAt().AsTime()
+		r.PublishedAt = &publishedAt
+	}
+	if post.GetCreatedAt() != nil {
+		createdAt := post.GetCreatedAt().AsTime()
+		r.CreatedAt = &createdAt
+	}
+	return r
+}
+
+// Marshal 按指定格式编码一篇博文，JSONL格式不包含末尾的换行符
+func Marshal(r *Record, format Format) ([]byte, error) {
+	if format != Markdown {
+		return json.Marshal(r)
+	}
+	meta, err := yaml.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteString(frontMatterDelimiter + "\n")
+	buf.Write(meta)
+	buf.WriteString(frontMatterDelimiter + "\n")
+	buf.WriteString(r.Content)
+	return buf.Bytes(), nil
+}
+
+// front matter的起止行
+const frontMatterDelimiter = "---"
+
+// Unmarshal 按指定格式解码一篇博文
+func Unmarshal(data []byte, format Format) (*Record, error) {
+	var r Record
+	if format != Markdown {
+		if err := json.Unmarshal(data, &r); err != nil {
+			return nil, err
+		}
+		return &r, nil
+	}
+
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+	rest, ok := strings.CutPrefix(text, frontMatterDelimiter+"\n")
+	if !ok {
+		return nil, errors.New("markdown file must start with front matter")
+	}
+	meta, content, ok := strings.Cut(rest, "\n"+frontMatterDelimiter+"\n")
+	if !ok {
+		// 只有front matter没有内容
+		meta, ok = strings.CutSuffix(rest, "\n"+frontMatterDelimiter)
+		if !ok {
+			return nil, errors.New("front matter is not closed")
+		}
+	}
+	if err := yaml.Unmarshal([]byte(meta), &r); err != nil {
+		return nil, fmt.Errorf("invalid front matter: %w", err)
+	}
+	r.Content = content
+	return &r, nil
+}
@@ -0,0 +1,78 @@
+package postio
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func testRecord() *Record {
+	published := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	return &Record{
+		ExternalID:  "wp-42",
+		Author:      "alice",
+		Title:       "Hello: world",
+		Status:      "published",
+		Tags:        []string{"go", "blog"},
+		PublishedAt: &published,
+		Content:     "# Title\n\n---\n\nbody\n",
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	for _, format := range []Format{JSONL, Markdown} {
+		data, err := Marshal(testRecord(), format)
+		assert.NoError(t, err)
+		if format == JSONL {
+			assert.NotContains(t, string(data), "\n")
+		}
+
+		got, err := Unmarshal(data, format)
+		assert.NoError(t, err)
+		assert.Equal(t, testRecord(), got, format)
+	}
+}
+
+func TestUnmarshalMarkdown(t *testing.T) {
+	got, err := Unmarshal([]byte("---\r\nexternalID: a\r\ntitle: t\r\n---\r\nbody"), Markdown)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", got.ExternalID)
+	assert.Equal(t, "body", got.Content)
+
+	_, err = Unmarshal([]byte("no front matter"), Markdown)
+	assert.Error(t, err)
+	_, err = Unmarshal([]byte("---\ntitle: t\n"), Markdown)
+	assert.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, testRecord().Validate())
+
+	tests := map[string]func(r *Record){
+		"empty externalID": func(r *Record) { r.ExternalID = "" },
+		"long externalID":  func(r *Record) { r.ExternalID = strings.Repeat("x", maxExternalIDLength+1) },
+		"empty author":     func(r *Record) { r.Author = "" },
+		"empty content":    func(r *Record) { r.Content = "" },
+		"invalid status":   func(r *Record) { r.Status = "gone" },
+		"invalid format":   func(r *Record) { r.Format = "rst" },
+		"empty tag":        func(r *Record) { r.Tags = []string{" "} },
+	}
+	for name, mutate := range tests {
+		r := testRecord()
+		mutate(r)
+		assert.Error(t, r.Validate(), name)
+	}
+}
+
+func TestRecordEnums(t *testing.T) {
+	r := &Record{Format: "HTML", Status: "Archived"}
+	format, err := r.PostFormat()
+	assert.NoError(t, err)
+	status, err := r.PostStatus()
+	assert.NoError(t, err)
+	assert.Equal(t, apiv1.PostStatus_Archived, status)
+	assert.Equal(t, apiv1.PostFormat_HTML, format)
+}
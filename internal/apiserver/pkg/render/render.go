@@ -0,0 +1,82 @@
+package render
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"html"
+	"strings"
+
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"k8s.io/utils/lru"
+)
+
+// Renderer 将博文内容渲染为可以直接嵌入页面的HTML
+// 所有格式的输出都会经过白名单过滤，避免XSS
+// 渲染结果按博文和内容摘要缓存，博文每个版本的内容只渲染一次
+type Renderer struct {
+	cache    *lru.Cache
+	markdown goldmark.Markdown
+	policy   *bluemonday.Policy
+}
+
+// New 创建一个最多缓存size条渲染结果的Renderer，Renderer可以被并发使用
+func New(size int) *Renderer {
+	return &Renderer{
+		cache:    lru.New(size),
+		markdown: goldmark.New(goldmark.WithExtensions(extension.GFM)),
+		policy:   bluemonday.UGCPolicy(),
+	}
+}
+
+// Render 返回博文内容对应的安全HTML
+func (r *Renderer) Render(postID string, format apiv1.PostFormat, content string) string {
+	key := cacheKey(postID, format, content)
+	if cached, ok := r.cache.Get(key); ok {
+		return cached.(string)
+	}
+
+	rendered := r.policy.Sanitize(r.render(format, content))
+	r.cache.Add(key, rendered)
+	return rendered
+}
+
+func (r *Renderer) render(format apiv1.PostFormat, content string) string {
+	switch format {
+	case apiv1.PostFormat_HTML:
+		return content
+	case apiv1.PostFormat_Plain:
+		return plainToHTML(content)
+	default:
+		var buf bytes.Buffer
+		if err := r.markdown.Convert([]byte(content), &buf); err != nil {
+			return plainToHTML(content)
+		}
+		return buf.String()
+	}
+}
+
+// plainToHTML 转义纯文本，空行分隔段落，段落内换行保留为<br>
+func plainToHTML(content string) string {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	var buf strings.Builder
+	for _, paragraph := range strings.Split(content, "\n\n") {
+		paragraph = strings.Trim(paragraph, "\n")
+		if paragraph == "" {
+			continue
+		}
+		buf.WriteString("<p>")
+		buf.WriteString(strings.ReplaceAll(html.EscapeString(paragraph), "\n", "<br>\n"))
+		buf.WriteString("</p>\n")
+	}
+	return buf.String()
+}
+
+// cacheKey 使用内容摘要区分同一博文的不同版本，博文修改后旧的缓存条目自然失效
+func cacheKey(postID string, format apiv1.PostFormat, content string) string {
+	sum := sha256.Sum256([]byte(format.String() + "\x00" + content))
+	return postID + ":" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,35 @@
+package render
+
+import (
+	"testing"
+
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	r := New(8)
+	assert.Equal(t, "<h1>Title</h1>\n<p><strong>bold</strong> <del>gone</del></p>\n",
+		r.Render("post-1", apiv1.PostFormat_Markdown, "# Title\n\n**bold** ~~gone~~"))
+	assert.NotContains(t, r.Render("post-1", apiv1.PostFormat_Markdown, "[x](javascript:alert(1))"), "javascript:")
+	assert.NotContains(t, r.Render("post-1", apiv1.PostFormat_Markdown, "<script>alert(1)</script>"), "<script>")
+}
+
+func TestRenderPlain(t *testing.T) {
+	r := New(8)
+	assert.Equal(t, "<p>a &lt;b&gt;<br>\nc</p>\n<p>d</p>\n", r.Render("post-1", apiv1.PostFormat_Plain, "a <b>\nc\n\nd"))
+}
+
+func TestRenderHTML(t *testing.T) {
+	r := New(8)
+	assert.Equal(t, `<p>hi</p>`, r.Render("post-1", apiv1.PostFormat_HTML, `<p onclick="x()">hi</p><script>alert(1)</script>`))
+}
+
+func TestRenderCache(t *testing.T) {
+	r := New(8)
+	r.Render("post-1", apiv1.PostFormat_Markdown, "v1")
+	r.Render("post-1", apiv1.PostFormat_Markdown, "v1")
+	r.Render("post-1", apiv1.PostFormat_Markdown, "v2")
+	r.Render("post-1", apiv1.PostFormat_Plain, "v2")
+	assert.Equal(t, 3, r.cache.Len())
+}
@@ -0,0 +1,80 @@
+// Package scheduler 在服务进程内周期性地执行后台任务，例如发布到期的定时博文
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ArthurWang23/miniblog/internal/pkg/log"
+)
+
+// Job 表示一个周期执行的后台任务
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+	// 为true时调度器停止前再执行一次，用于写回内存中缓存的数据
+	RunOnStop bool
+}
+
+type Scheduler struct {
+	jobs []Job
+	stop chan struct{}
+	once sync.Once
+	wg   sync.WaitGroup
+}
+
+func New(jobs ...Job) *Scheduler {
+	return &Scheduler{jobs: jobs, stop: make(chan struct{})}
+}
+
+// Start 为每个任务启动一个goroutine，按照任务的Interval周期执行
+func (s *Scheduler) Start() {
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(job)
+	}
+}
+
+// Stop 停止调度并等待正在执行的任务结束，ctx超时后不再等待
+func (s *Scheduler) Stop(ctx context.Context) {
+	log.Infow("Gracefully stop scheduler")
+	s.once.Do(func() { close(s.stop) })
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		log.Errorw("Scheduler forced to stop", "err", ctx.Err())
+	}
+}
+
+func (s *Scheduler) loop(job Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			if job.RunOnStop {
+				run(job)
+			}
+			return
+		case <-ticker.C:
+			// 任务执行期间不响应停止信号，保证单次执行完整结束
+			run(job)
+		}
+	}
+}
+
+func run(job Job) {
+	if err := job.Run(context.Background()); err != nil {
+		log.Errorw("Failed to run scheduled job", "job", job.Name, "err", err)
+	}
+}
@@ -0,0 +1,49 @@
+package scheduler
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestScheduler(t *testing.T) {
+	var runs atomic.Int32
+	s := New(Job{Name: "test", Interval: 10 * time.Millisecond, Run: func(ctx context.Context) error {
+		runs.Add(1)
+		return nil
+	}})
+	s.Start()
+	time.Sleep(55 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	s.Stop(ctx)
+	stopped := runs.Load()
+	if stopped == 0 {
+		t.Fatal("job never ran")
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	if runs.Load() != stopped {
+		t.Errorf("job ran after Stop: %d != %d", runs.Load(), stopped)
+	}
+	// 重复调用Stop不会panic
+	s.Stop(ctx)
+}
+
+func TestSchedulerRunOnStop(t *testing.T) {
+	var runs atomic.Int32
+	s := New(Job{Name: "flush", Interval: time.Hour, RunOnStop: true, Run: func(ctx context.Context) error {
+		runs.Add(1)
+		return nil
+	}})
+	s.Start()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	s.Stop(ctx)
+	if runs.Load() != 1 {
+		t.Errorf("job should run once on stop, got %d", runs.Load())
+	}
+}
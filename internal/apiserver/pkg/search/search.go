@@ -0,0 +1,148 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 全文检索辅助函数
+// MySQL FULLTEXT（ngram 分词器）和 SQLite FTS5（trigram 分词器）都按不区分大小写的子串匹配关键词，
+// 但不方便返回一致的高亮片段，所以检索只负责按相关度排序，高亮和摘要统一在这里按相同的规则生成
+
+const (
+	// 单次检索最多使用的关键词个数
+	maxTerms = 10
+	// 关键词的最少字符数，与 MySQL ngram 分词器默认的 ngram_token_size 一致
+	MinTermLength = 2
+
+	markOpen  = "<mark>"
+	markClose = "</mark>"
+	ellipsis  = "..."
+)
+
+// Terms 将用户输入拆分为关键词
+// 非字母、数字的字符都会被当作分隔符，避免用户输入干扰全文检索语法
+// 少于MinTermLength个字符的关键词无法通过全文索引检索，会被忽略
+func Terms(query string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	terms := make([]string, 0, len(fields))
+	seen := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		if utf8.RuneCountInString(field) < MinTermLength {
+			continue
+		}
+		if _, ok := seen[field]; ok {
+			continue
+		}
+		seen[field] = struct{}{}
+		terms = append(terms, field)
+		if len(terms) == maxTerms {
+			break
+		}
+	}
+	return terms
+}
+
+// Highlight 对text做HTML转义，并用<mark>标签包裹所有命中的关键词
+// 与全文检索一致，关键词按不区分大小写的子串匹配，例如关键词go会高亮golang中的go
+func Highlight(text string, terms []string) string {
+	return highlight([]rune(text), terms)
+}
+
+// Snippet 截取text中第一个命中关键词附近最多size个字符的片段，并高亮其中的关键词
+// 未命中时返回text开头的片段
+func Snippet(text string, terms []string, size int) string {
+	runes := []rune(text)
+	if size <= 0 || len(runes) <= size {
+		return highlight(runes, terms)
+	}
+
+	start := 0
+	if pos, _ := match(lower(runes), lowerTerms(terms), 0); pos >= 0 {
+		// 关键词前保留约三分之一的上下文
+		start = max(0, pos-size/3)
+	}
+	end := min(len(runes), start+size)
+	start = max(0, end-size)
+
+	var sb strings.Builder
+	if start > 0 {
+		sb.WriteString(ellipsis)
+	}
+	sb.WriteString(highlight(runes[start:end], terms))
+	if end < len(runes) {
+		sb.WriteString(ellipsis)
+	}
+	return sb.String()
+}
+
+func highlight(runes []rune, terms []string) string {
+	lowered, needles := lower(runes), lowerTerms(terms)
+
+	var sb strings.Builder
+	for i := 0; i < len(runes); {
+		pos, n := match(lowered, needles, i)
+		if pos < 0 {
+			sb.WriteString(html.EscapeString(string(runes[i:])))
+			break
+		}
+		sb.WriteString(html.EscapeString(string(runes[i:pos])))
+		sb.WriteString(markOpen)
+		sb.WriteString(html.EscapeString(string(runes[pos : pos+n])))
+		sb.WriteString(markClose)
+		i = pos + n
+	}
+	return sb.String()
+}
+
+// match 从from开始查找最早出现的关键词，返回其位置和长度，同一位置优先匹配最长的关键词
+func match(text []rune, needles [][]rune, from int) (int, int) {
+	for i := from; i < len(text); i++ {
+		n := 0
+		for _, needle := range needles {
+			if len(needle) > n && hasPrefix(text[i:], needle) {
+				n = len(needle)
+			}
+		}
+		if n > 0 {
+			return i, n
+		}
+	}
+	return -1, 0
+}
+
+func hasPrefix(text, prefix []rune) bool {
+	if len(prefix) > len(text) {
+		return false
+	}
+	for i := range prefix {
+		if text[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// lower 按字符转换为小写，保证转换前后字符位置一一对应
+func lower(runes []rune) []rune {
+	ret := make([]rune, len(runes))
+	for i, r := range runes {
+		ret[i] = unicode.ToLower(r)
+	}
+	return ret
+}
+
+func lowerTerms(terms []string) [][]rune {
+	ret := make([][]rune, 0, len(terms))
+	for _, term := range terms {
+		if utf8.RuneCountInString(term) >= MinTermLength {
+			ret = append(ret, lower([]rune(term)))
+		}
+	}
+	return ret
+}
@@ -0,0 +1,29 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerms(t *testing.T) {
+	assert.Equal(t, []string{"go", "gorm", "mysql"}, Terms(`Go "gorm" +MySQL* go`))
+	assert.Empty(t, Terms(` "*+-() `))
+	// 少于2个字符的关键词无法通过全文索引检索
+	assert.Equal(t, []string{"博客", "go"}, Terms("a 博客 的 go"))
+}
+
+func TestHighlight(t *testing.T) {
+	assert.Equal(t, "Learn <mark>Go</mark> &amp; <mark>gorm</mark>", Highlight("Learn Go & gorm", []string{"go", "gorm"}))
+	assert.Equal(t, "&lt;script&gt;", Highlight("<script>", []string{"go"}))
+	// 与全文检索一致按子串匹配
+	assert.Equal(t, "<mark>Go</mark>lang 与<mark>博客</mark>系统", Highlight("Golang 与博客系统", []string{"go", "博客"}))
+	assert.Equal(t, "a b", Highlight("a b", []string{"a"}))
+}
+
+func TestSnippet(t *testing.T) {
+	text := "aaaaaaaaaa keyword bbbbbbbbbb"
+	assert.Equal(t, "...aaaa <mark>keyword</mark> bbbb...", Snippet(text, []string{"keyword"}, 17))
+	assert.Equal(t, "aaaaa...", Snippet(text, []string{"missing"}, 5))
+	assert.Equal(t, "short", Snippet("short", nil, 10))
+}
@@ -0,0 +1,44 @@
+package slug
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+// 生成slug时的最大字符数
+const maxLength = 64
+
+// Make 根据标题生成slug：字母统一转为小写，字母和数字以外的字符替换为连字符
+// 中文等非ASCII字母会被保留，标题中没有可用字符时返回"post"
+func Make(title string) string {
+	var b strings.Builder
+	count := 0
+	pendingDash := false
+	for _, r := range strings.ToLower(title) {
+		if count >= maxLength {
+			break
+		}
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			pendingDash = count > 0
+			continue
+		}
+		if pendingDash {
+			b.WriteRune('-')
+			count++
+			pendingDash = false
+		}
+		b.WriteRune(r)
+		count++
+	}
+	s := strings.TrimRight(b.String(), "-")
+	if s == "" {
+		return "post"
+	}
+	return s
+}
+
+// Path 返回博文的永久链接路径，例如 /u/alice/my-first-post
+func Path(username string, slug string) string {
+	return "/u/" + url.PathEscape(username) + "/" + url.PathEscape(slug)
+}
@@ -0,0 +1,21 @@
+package slug
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMake(t *testing.T) {
+	assert.Equal(t, "my-first-post", Make("My First Post!"))
+	assert.Equal(t, "go-1-24-released", Make("  Go 1.24 -- released  "))
+	assert.Equal(t, "学习-gorm", Make("学习 GORM"))
+	assert.Equal(t, "post", Make("?!"))
+	assert.Len(t, Make(strings.Repeat("abcdefghi ", 10)), 64)
+}
+
+func TestPath(t *testing.T) {
+	assert.Equal(t, "/u/alice/my-first-post", Path("alice", "my-first-post"))
+	assert.Equal(t, "/u/alice/%E5%AD%A6%E4%B9%A0", Path("alice", "学习"))
+}
@@ -0,0 +1,53 @@
+// Package timeline 生成用户关注的人发布的博文时间线
+// 当前实现在读取时查询关注关系（fan-out on read），关注数量或读取量增大后
+// 可以新增在发布时写入关注者收件箱（fan-out on write）的实现替换，调用方只依赖Timeline接口
+package timeline
+
+import (
+	"context"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/orderby"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/pagetoken"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+)
+
+// Timeline 按发布时间倒序读取userID关注的人发布的博文
+type Timeline interface {
+	// Read 返回pageToken之后的最多limit篇博文以及下一页的令牌，没有更多数据时令牌为空
+	Read(ctx context.Context, userID string, pageToken string, limit int64) ([]*model.PostM, string, error)
+}
+
+// 时间线固定按首次发布时间倒序排列，已发布的博文一定有首次发布时间
+var orders = orderby.Terms[model.PostM]{{
+	Name:  "publishedAt",
+	Desc:  true,
+	Field: orderby.Field[model.PostM]{Column: "publishedAt", Value: func(post *model.PostM) any { return post.PublishedAt }},
+}}
+
+type fanOutOnRead struct {
+	store store.IStore
+}
+
+var _ Timeline = (*fanOutOnRead)(nil)
+
+// NewFanOutOnRead 创建读取时通过子查询筛选关注者博文的时间线，不需要额外的存储
+func NewFanOutOnRead(store store.IStore) Timeline {
+	return &fanOutOnRead{store: store}
+}
+
+func (t *fanOutOnRead) Read(ctx context.Context, userID string, pageToken string, limit int64) ([]*model.PostM, string, error) {
+	query, args := store.FollowedBy(userID)
+	whr := where.F("status", int32(apiv1.PostStatus_Published)).Q(query, args...).NoCount()
+	if err := pagetoken.Paginate(whr, pageToken, limit, orders); err != nil {
+		return nil, "", err
+	}
+	_, postList, err := t.store.Post().List(ctx, whr)
+	if err != nil {
+		return nil, "", err
+	}
+	postList, nextPageToken := pagetoken.NextPage(postList, limit, func(post *model.PostM) int64 { return post.ID }, orders)
+	return postList, nextPageToken, nil
+}
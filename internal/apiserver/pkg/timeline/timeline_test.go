@@ -0,0 +1,77 @@
+package timeline
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store/storetest"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+)
+
+func TestFanOutOnReadPagination(t *testing.T) {
+	db, st := storetest.New(t)
+	users := storetest.Users(t, 4)
+	reader, followees, stranger := users[0], users[1:3], users[3]
+	ctx := storetest.Context(reader)
+	for _, followee := range followees {
+		require.NoError(t, db.Create(&model.FollowM{FollowerID: reader.UserID, FolloweeID: followee.UserID}).Error)
+	}
+
+	base := time.Now().UTC().Truncate(time.Second)
+	create := func(user *model.UserM, status apiv1.PostStatus, publishedAt time.Time) *model.PostM {
+		post := &model.PostM{UserID: user.UserID, Title: "post", Content: "content", Status: int32(status), PublishedAt: &publishedAt}
+		require.NoError(t, db.Create(post).Error)
+		return post
+	}
+	var want []*model.PostM
+	// 部分博文的发布时间相同，翻页时按ID区分先后
+	for i, offset := range []int{0, 1, 1, 1, 2, 3, 3} {
+		want = append(want, create(followees[i%2], apiv1.PostStatus_Published, base.Add(-time.Duration(offset)*time.Minute)))
+	}
+	create(stranger, apiv1.PostStatus_Published, base)
+	create(followees[0], apiv1.PostStatus_Draft, base)
+	slices.SortFunc(want, func(a, b *model.PostM) int {
+		if c := b.PublishedAt.Compare(*a.PublishedAt); c != 0 {
+			return c
+		}
+		return int(b.ID - a.ID)
+	})
+
+	tl := NewFanOutOnRead(st)
+	var got []string
+	pageToken := ""
+	for page := 0; ; page++ {
+		require.Less(t, page, len(want), "pagination does not terminate")
+		posts, next, err := tl.Read(ctx, reader.UserID, pageToken, 2)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(posts), 2)
+		for _, post := range posts {
+			got = append(got, post.PostID)
+		}
+		if page == 0 {
+			// 翻页过程中新发布的博文不影响后续页
+			create(followees[0], apiv1.PostStatus_Published, base.Add(time.Hour))
+		}
+		if next == "" {
+			break
+		}
+		pageToken = next
+	}
+
+	wantIDs := make([]string, 0, len(want))
+	for _, post := range want {
+		wantIDs = append(wantIDs, post.PostID)
+	}
+	assert.Equal(t, wantIDs, got)
+
+	// 没有关注任何人时时间线为空
+	posts, next, err := tl.Read(storetest.Context(stranger), stranger.UserID, "", 2)
+	require.NoError(t, err)
+	assert.Empty(t, posts)
+	assert.Empty(t, next)
+}
@@ -0,0 +1,28 @@
+package validation
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	genericvalidation "github.com/ArthurWang23/miniblog/pkg/validation"
+)
+
+// 收藏备注的最大字符数
+const maxBookmarkNoteLength = 1024
+
+func (v *Validator) ValidateAddBookmarkRequest(ctx context.Context, rq *apiv1.AddBookmarkRequest) error {
+	if utf8.RuneCountInString(rq.GetNote()) > maxBookmarkNoteLength {
+		return errno.ErrInvalidArgument.WithMessage("note cannot be longer than %d characters", maxBookmarkNoteLength)
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
+
+func (v *Validator) ValidateRemoveBookmarkRequest(ctx context.Context, rq *apiv1.RemoveBookmarkRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
+
+func (v *Validator) ValidateListBookmarksRequest(ctx context.Context, rq *apiv1.ListBookmarksRequest) error {
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidatePostRules(), "Offset", "Limit")
+}
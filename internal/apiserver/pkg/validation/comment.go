@@ -0,0 +1,55 @@
+package validation
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	genericvalidation "github.com/ArthurWang23/miniblog/pkg/validation"
+)
+
+// 评论内容的最大字符数
+const maxCommentLength = 1000
+
+func (v *Validator) ValidateCommentRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"PostID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("postID cannot be empty")
+			}
+			return nil
+		},
+		"CommentID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("commentID cannot be empty")
+			}
+			return nil
+		},
+		"Content": func(value any) error {
+			content := value.(string)
+			if content == "" {
+				return errno.ErrInvalidArgument.WithMessage("content cannot be empty")
+			}
+			if utf8.RuneCountInString(content) > maxCommentLength {
+				return errno.ErrInvalidArgument.WithMessage("content cannot be longer than %d characters", maxCommentLength)
+			}
+			return nil
+		},
+	}
+}
+
+func (v *Validator) ValidateCreateCommentRequest(ctx context.Context, rq *apiv1.CreateCommentRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateCommentRules())
+}
+
+func (v *Validator) ValidateListCommentsRequest(ctx context.Context, rq *apiv1.ListCommentsRequest) error {
+	if err := validatePublicPage(rq.GetOffset(), &rq.Limit); err != nil {
+		return err
+	}
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidateCommentRules(), "PostID")
+}
+
+func (v *Validator) ValidateDeleteCommentRequest(ctx context.Context, rq *apiv1.DeleteCommentRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateCommentRules())
+}
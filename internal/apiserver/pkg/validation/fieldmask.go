@@ -0,0 +1,38 @@
+package validation
+
+import (
+	"strings"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/fieldmask"
+	genericvalidation "github.com/ArthurWang23/miniblog/pkg/validation"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// validateMaskedFields 校验updateMask中的字段
+// 字段未设置值时会被清空，因此按values中的零值校验，避免通过updateMask清空必填字段
+// values的键为proto中的字段名，不在values中的字段不校验，字段是否允许修改由业务层判断
+func validateMaskedFields(mask *fieldmaskpb.FieldMask, rules genericvalidation.Rules, values map[string]any) error {
+	for _, path := range mask.GetPaths() {
+		paths := []string{fieldmask.Normalize(path)}
+		if path == fieldmask.Wildcard {
+			paths = paths[:0]
+			for name := range values {
+				paths = append(paths, name)
+			}
+		}
+		for _, name := range paths {
+			value, ok := values[name]
+			if !ok {
+				continue
+			}
+			rule, ok := rules[strings.ToUpper(name[:1])+name[1:]]
+			if !ok {
+				continue
+			}
+			if err := rule(value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
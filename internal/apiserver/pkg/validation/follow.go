@@ -0,0 +1,32 @@
+package validation
+
+import (
+	"context"
+
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	genericvalidation "github.com/ArthurWang23/miniblog/pkg/validation"
+)
+
+func (v *Validator) ValidateFollowRequest(ctx context.Context, rq *apiv1.FollowRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
+}
+
+func (v *Validator) ValidateUnfollowRequest(ctx context.Context, rq *apiv1.UnfollowRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
+}
+
+func (v *Validator) ValidateListFollowersRequest(ctx context.Context, rq *apiv1.ListFollowersRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
+}
+
+func (v *Validator) ValidateListFollowingRequest(ctx context.Context, rq *apiv1.ListFollowingRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
+}
+
+// ValidateGetTimelineRequest 时间线必须分页读取
+func (v *Validator) ValidateGetTimelineRequest(ctx context.Context, rq *apiv1.GetTimelineRequest) error {
+	if err := validatePageToken(rq.GetPageToken(), 0); err != nil {
+		return err
+	}
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidateUserRules(), "Limit")
+}
@@ -0,0 +1,31 @@
+package validation
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	genericvalidation "github.com/ArthurWang23/miniblog/pkg/validation"
+)
+
+// 审核不通过原因的最大字符数
+const maxModerationReasonLength = 256
+
+func (v *Validator) ValidateListModeratedPostsRequest(ctx context.Context, rq *apiv1.ListModeratedPostsRequest) error {
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidatePostRules(), "Offset", "Limit")
+}
+
+func (v *Validator) ValidateApproveModeratedPostRequest(ctx context.Context, rq *apiv1.ApproveModeratedPostRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
+
+func (v *Validator) ValidateRejectModeratedPostRequest(ctx context.Context, rq *apiv1.RejectModeratedPostRequest) error {
+	if rq.GetReason() == "" {
+		return errno.ErrInvalidArgument.WithMessage("reason cannot be empty")
+	}
+	if utf8.RuneCountInString(rq.GetReason()) > maxModerationReasonLength {
+		return errno.ErrInvalidArgument.WithMessage("reason cannot be longer than %d characters", maxModerationReasonLength)
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
@@ -0,0 +1,30 @@
+package validation
+
+import (
+	"context"
+
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	genericvalidation "github.com/ArthurWang23/miniblog/pkg/validation"
+)
+
+// 单次最多标记为已读的通知数
+const maxMarkNotifications = 100
+
+func (v *Validator) ValidateListNotificationsRequest(ctx context.Context, rq *apiv1.ListNotificationsRequest) error {
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidateUserRules(), "Offset", "Limit")
+}
+
+func (v *Validator) ValidateMarkNotificationsReadRequest(ctx context.Context, rq *apiv1.MarkNotificationsReadRequest) error {
+	if rq.GetAll() == (len(rq.GetNotificationIDs()) > 0) {
+		return errno.ErrInvalidArgument.WithMessage("exactly one of notificationIDs and all must be set")
+	}
+	if len(rq.GetNotificationIDs()) > maxMarkNotifications {
+		return errno.ErrInvalidArgument.WithMessage("cannot mark more than %d notifications at once", maxMarkNotifications)
+	}
+	return nil
+}
+
+func (v *Validator) ValidateGetUnreadNotificationCountRequest(ctx context.Context, rq *apiv1.GetUnreadNotificationCountRequest) error {
+	return nil
+}
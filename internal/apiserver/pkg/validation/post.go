@@ -2,12 +2,26 @@ package validation
 
 import (
 	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
 
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/postio"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/search"
 	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
 	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
 	genericvalidation "github.com/ArthurWang23/miniblog/pkg/validation"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
-	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// 单篇博文最多可以设置的标签数
+	maxPostTags = 10
+	// 标签名称的最大字符数
+	maxTagLength = 32
+	// 博文标题的最大字符数，与post表title列的长度一致
+	maxTitleLength = 256
 )
 
 func (v *Validator) ValidatePostRules() genericvalidation.Rules {
@@ -30,14 +44,67 @@ func (v *Validator) ValidatePostRules() genericvalidation.Rules {
 			}
 			return nil
 		},
+		"Revision": func(value any) error {
+			if value.(int64) <= 0 {
+				return errno.ErrInvalidArgument.WithMessage("revision must be greater than 0")
+			}
+			return nil
+		},
+		"Format": func(value any) error {
+			format := value.(apiv1.PostFormat)
+			if _, ok := apiv1.PostFormat_name[int32(format)]; !ok {
+				return errno.ErrInvalidArgument.WithMessage("invalid post format: %d", format)
+			}
+			return nil
+		},
+		"Tags": func(value any) error {
+			tags := value.([]string)
+			if len(tags) > maxPostTags {
+				return errno.ErrInvalidArgument.WithMessage("a post can have at most %d tags", maxPostTags)
+			}
+			for _, tag := range tags {
+				tag = strings.TrimSpace(tag)
+				if tag == "" {
+					return errno.ErrInvalidArgument.WithMessage("tag cannot be empty")
+				}
+				if utf8.RuneCountInString(tag) > maxTagLength {
+					return errno.ErrInvalidArgument.WithMessage("tag cannot be longer than %d characters", maxTagLength)
+				}
+			}
+			return nil
+		},
 	}
 }
 
 func (v *Validator) ValidateCreatePostRequest(ctx context.Context, rq *apiv1.CreatePostRequest) error {
+	if err := validatePublishAt(rq.GetPublishAt()); err != nil {
+		return err
+	}
 	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
 }
 
 func (v *Validator) ValidateUpdatePostRequest(ctx context.Context, rq *apiv1.UpdatePostRequest) error {
+	if rq.GetClearTags() && len(rq.GetTags()) > 0 {
+		return errno.ErrInvalidArgument.WithMessage("tags and clearTags cannot be set at the same time")
+	}
+	if rq.GetClearPublishAt() && rq.PublishAt != nil {
+		return errno.ErrInvalidArgument.WithMessage("publishAt and clearPublishAt cannot be set at the same time")
+	}
+	if rq.UpdateMask != nil {
+		if rq.GetClearTags() || rq.GetClearPublishAt() {
+			return errno.ErrInvalidArgument.WithMessage("clearTags and clearPublishAt cannot be used with updateMask")
+		}
+		values := map[string]any{"title": rq.GetTitle(), "content": rq.GetContent(), "tags": rq.GetTags()}
+		if err := validateMaskedFields(rq.GetUpdateMask(), v.ValidatePostRules(), values); err != nil {
+			return err
+		}
+	}
+	if rq.ExpectedVersion != nil && rq.GetExpectedVersion() <= 0 {
+		return errno.ErrInvalidArgument.WithMessage("expectedVersion must be greater than 0")
+	}
+	if err := validatePublishAt(rq.GetPublishAt()); err != nil {
+		return err
+	}
 	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
 }
 
@@ -50,13 +117,129 @@ func (v *Validator) ValidateGetPostRequest(ctx context.Context, rq *apiv1.GetPos
 }
 
 func (v *Validator) ValidateListPostRequest(ctx context.Context, rq *apiv1.ListPostRequest) error {
-	if err := validation.Validate(rq.GetTitle(), validation.Length(5, 100), is.URL); err != nil {
+	if err := validatePageToken(rq.GetPageToken(), rq.GetOffset()); err != nil {
+		return err
+	}
+	if err := validateTimeRange("created", rq.GetCreatedAfter(), rq.GetCreatedBefore()); err != nil {
+		return err
+	}
+	if err := validateTimeRange("updated", rq.GetUpdatedAfter(), rq.GetUpdatedBefore()); err != nil {
+		return err
+	}
+	if rq.Status != nil {
+		if _, ok := apiv1.PostStatus_name[int32(rq.GetStatus())]; !ok {
+			return errno.ErrInvalidArgument.WithMessage("invalid post status: %d", rq.GetStatus())
+		}
+	}
+	if err := validation.Validate(rq.GetTitle(), validation.RuneLength(0, maxTitleLength)); err != nil {
 		return errno.ErrInvalidArgument.WithMessage("%s", err.Error())
 	}
-	return genericvalidation.ValidateSelectedFields(rq, v.ValidatePostRules(), "Offset", "Limit")
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidatePostRules(), "Offset", "Limit", "Tags")
 
 	// if rq.Title != nil && len(rq.Title) > 200 {
 	// 	return errno.ErrInvalidArgument.WithMessage("title cannot be longer than 200 characters")
 	// }
 	// return genericvalidation.ValidateSelectedFields(rq,v.ValidatePostRules(),"Offset","Limit")
 }
+
+func (v *Validator) ValidatePublishPostRequest(ctx context.Context, rq *apiv1.PublishPostRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
+
+func (v *Validator) ValidateUnpublishPostRequest(ctx context.Context, rq *apiv1.UnpublishPostRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
+
+func (v *Validator) ValidateArchivePostRequest(ctx context.Context, rq *apiv1.ArchivePostRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
+
+func (v *Validator) ValidateListPublicPostsRequest(ctx context.Context, rq *apiv1.ListPublicPostsRequest) error {
+	return validatePublicPage(rq.GetOffset(), &rq.Limit)
+}
+
+func (v *Validator) ValidateGetPublicPostRequest(ctx context.Context, rq *apiv1.GetPublicPostRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
+
+func (v *Validator) ValidateListDeletedPostsRequest(ctx context.Context, rq *apiv1.ListDeletedPostsRequest) error {
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidatePostRules(), "Offset", "Limit")
+}
+
+func (v *Validator) ValidateRestorePostRequest(ctx context.Context, rq *apiv1.RestorePostRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
+
+func (v *Validator) ValidateGetPostBySlugRequest(ctx context.Context, rq *apiv1.GetPostBySlugRequest) error {
+	if rq.GetUsername() == "" || rq.GetSlug() == "" {
+		return errno.ErrInvalidArgument.WithMessage("username and slug cannot be empty")
+	}
+	return nil
+}
+
+func (v *Validator) ValidateSearchPostsRequest(ctx context.Context, rq *apiv1.SearchPostsRequest) error {
+	if len(rq.GetQuery()) > 100 {
+		return errno.ErrInvalidArgument.WithMessage("query cannot be longer than 100 characters")
+	}
+	if len(search.Terms(rq.GetQuery())) == 0 {
+		return errno.ErrInvalidArgument.WithMessage("query must contain at least one keyword")
+	}
+	return nil
+}
+
+func (v *Validator) ValidateLikePostRequest(ctx context.Context, rq *apiv1.LikePostRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
+
+func (v *Validator) ValidateUnlikePostRequest(ctx context.Context, rq *apiv1.UnlikePostRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
+
+func (v *Validator) ValidatePinPostRequest(ctx context.Context, rq *apiv1.PinPostRequest) error {
+	if rq.Position != nil && rq.GetPosition() <= 0 {
+		return errno.ErrInvalidArgument.WithMessage("position must be greater than 0")
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
+
+func (v *Validator) ValidateUnpinPostRequest(ctx context.Context, rq *apiv1.UnpinPostRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
+
+func (v *Validator) ValidateListPostRevisionsRequest(ctx context.Context, rq *apiv1.ListPostRevisionsRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
+
+func (v *Validator) ValidateGetPostRevisionRequest(ctx context.Context, rq *apiv1.GetPostRevisionRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
+
+func (v *Validator) ValidateRestorePostRevisionRequest(ctx context.Context, rq *apiv1.RestorePostRevisionRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
+
+func (v *Validator) ValidateDiffPostRevisionsRequest(ctx context.Context, rq *apiv1.DiffPostRevisionsRequest) error {
+	if rq.GetFrom() <= 0 || rq.GetTo() < 0 {
+		return errno.ErrInvalidArgument.WithMessage("from must be greater than 0 and to cannot be negative")
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidatePostRules())
+}
+
+// validatePublishAt 定时发布时间必须晚于当前时间，未设置时不校验
+func validatePublishAt(ts *timestamppb.Timestamp) error {
+	if ts != nil && !ts.AsTime().After(time.Now()) {
+		return errno.ErrInvalidArgument.WithMessage("publishAt must be in the future")
+	}
+	return nil
+}
+
+// ValidateImportPostsRequest 校验导入请求的第一条消息，format和author只从第一条消息中读取
+func (v *Validator) ValidateImportPostsRequest(ctx context.Context, rq *apiv1.ImportPostsRequest) error {
+	if _, err := postio.ParseFormat(rq.GetFormat()); err != nil {
+		return errno.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+	if rq.GetAuthor() != "" && !isValidUsername(rq.GetAuthor()) {
+		return errno.ErrInvalidArgument.WithMessage("invalid author: %s", rq.GetAuthor())
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package validation
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	apiv1 "github.com/ArthurWang23/miniblog/pkg/api/apiserver/v1"
+	genericvalidation "github.com/ArthurWang23/miniblog/pkg/validation"
+)
+
+const (
+	// 系列标题的最大字符数
+	maxSeriesTitleLength = 256
+	// 单个系列最多包含的博文数
+	maxSeriesPosts = 1000
+)
+
+func (v *Validator) ValidateSeriesRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"SeriesID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("seriesID cannot be empty")
+			}
+			return nil
+		},
+		"PostID": func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("postID cannot be empty")
+			}
+			return nil
+		},
+		"Title": func(value any) error {
+			title := value.(string)
+			if title == "" {
+				return errno.ErrInvalidArgument.WithMessage("title cannot be empty")
+			}
+			if utf8.RuneCountInString(title) > maxSeriesTitleLength {
+				return errno.ErrInvalidArgument.WithMessage("title cannot be longer than %d characters", maxSeriesTitleLength)
+			}
+			return nil
+		},
+		"PostIDs": func(value any) error {
+			if len(value.([]string)) > maxSeriesPosts {
+				return errno.ErrInvalidArgument.WithMessage("a series can have at most %d posts", maxSeriesPosts)
+			}
+			return nil
+		},
+	}
+}
+
+func (v *Validator) ValidateCreateSeriesRequest(ctx context.Context, rq *apiv1.CreateSeriesRequest) error {
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidateSeriesRules(), "Title")
+}
+
+func (v *Validator) ValidateAddPostToSeriesRequest(ctx context.Context, rq *apiv1.AddPostToSeriesRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateSeriesRules())
+}
+
+func (v *Validator) ValidateReorderSeriesRequest(ctx context.Context, rq *apiv1.ReorderSeriesRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateSeriesRules())
+}
+
+func (v *Validator) ValidateGetSeriesRequest(ctx context.Context, rq *apiv1.GetSeriesRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateSeriesRules())
+}
@@ -77,6 +77,12 @@ func (v *Validator) ValidateUpdateUserRequest(ctx context.Context, rq *apiv1.Upd
 	if rq.GetUserID() != contextx.UserID(ctx) {
 		return errno.ErrPermissionDenied.WithMessage("The logged-in user `%s` does not match request user `%s`", contextx.UserID(ctx), rq.GetUserID())
 	}
+	if rq.UpdateMask != nil {
+		values := map[string]any{"username": rq.GetUsername(), "nickname": rq.GetNickname(), "email": rq.GetEmail(), "phone": rq.GetPhone()}
+		if err := validateMaskedFields(rq.GetUpdateMask(), v.ValidateUserRules(), values); err != nil {
+			return err
+		}
+	}
 	return genericvalidation.ValidateSelectedFields(rq, v.ValidateUserRules(), "UserID")
 }
 
@@ -91,6 +97,15 @@ func (v *Validator) ValidateGetUserRequest(ctx context.Context, rq *apiv1.GetUse
 	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
 }
 
-func (v *Validator) ValidateListUserRequest(ctx context.Context, rq *apiv1.ListUsersRequest) error {
+func (v *Validator) ValidateListUsersRequest(ctx context.Context, rq *apiv1.ListUsersRequest) error {
+	if err := validatePageToken(rq.GetPageToken(), rq.GetOffset()); err != nil {
+		return err
+	}
+	if err := validateTimeRange("created", rq.GetCreatedAfter(), rq.GetCreatedBefore()); err != nil {
+		return err
+	}
+	if err := validateTimeRange("updated", rq.GetUpdatedAfter(), rq.GetUpdatedBefore()); err != nil {
+		return err
+	}
 	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
 }
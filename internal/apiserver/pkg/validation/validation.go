@@ -3,9 +3,12 @@ package validation
 import (
 	"regexp"
 
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/pagetoken"
 	"github.com/ArthurWang23/miniblog/internal/apiserver/store"
 	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
+	"github.com/ArthurWang23/miniblog/internal/pkg/known"
 	"github.com/google/wire"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // 参数校验
@@ -87,3 +90,37 @@ func isValidPhone(phone string) error {
 	}
 	return nil
 }
+
+// validatePageToken 校验游标分页参数，pageToken与offset不能同时使用
+func validatePageToken(pageToken string, offset int64) error {
+	if pageToken == "" {
+		return nil
+	}
+	if offset > 0 {
+		return errno.ErrInvalidArgument.WithMessage("pageToken and offset cannot be set at the same time")
+	}
+	_, err := pagetoken.Decode(pageToken)
+	return err
+}
+
+// validatePublicPage 公开接口无需登录，未指定limit时使用默认值，并限制每页的最大条数
+func validatePublicPage(offset int64, limit *int64) error {
+	if offset < 0 {
+		return errno.ErrInvalidArgument.WithMessage("offset cannot be negative")
+	}
+	if *limit == 0 {
+		*limit = known.DefaultPublicPageSize
+	}
+	if *limit < 1 || *limit > known.MaxPublicPageSize {
+		return errno.ErrInvalidArgument.WithMessage("limit must be between 1 and %d", known.MaxPublicPageSize)
+	}
+	return nil
+}
+
+// validateTimeRange 校验时间范围，after必须早于before
+func validateTimeRange(name string, after, before *timestamppb.Timestamp) error {
+	if after != nil && before != nil && !after.AsTime().Before(before.AsTime()) {
+		return errno.ErrInvalidArgument.WithMessage("%sAfter must be earlier than %sBefore", name, name)
+	}
+	return nil
+}
@@ -1,6 +1,13 @@
 package validation
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArthurWang23/miniblog/internal/pkg/known"
+)
 
 func BenchmarkIsValidUsername(b *testing.B) {
 	testUsernames := []string{
@@ -19,3 +26,28 @@ func BenchmarkIsValidUsername(b *testing.B) {
 		}
 	}
 }
+
+func TestValidatePublicPage(t *testing.T) {
+	tests := []struct {
+		offset, limit int64
+		want          int64
+		wantErr       bool
+	}{
+		{limit: 0, want: known.DefaultPublicPageSize},
+		{limit: 1, want: 1},
+		{limit: known.MaxPublicPageSize, want: known.MaxPublicPageSize},
+		{limit: known.MaxPublicPageSize + 1, wantErr: true},
+		{limit: -1, wantErr: true},
+		{offset: -1, limit: 10, wantErr: true},
+	}
+	for _, tt := range tests {
+		limit := tt.limit
+		err := validatePublicPage(tt.offset, &limit)
+		if tt.wantErr {
+			assert.Error(t, err, "offset=%d limit=%d", tt.offset, tt.limit)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, limit)
+	}
+}
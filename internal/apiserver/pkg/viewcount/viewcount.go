@@ -0,0 +1,76 @@
+// Package viewcount 在内存中累计博文的浏览次数，由后台任务定期批量写回数据库，避免每次浏览都更新一次博文
+package viewcount
+
+import (
+	"sync"
+	"time"
+)
+
+type viewKey struct {
+	postID string
+	viewer string
+}
+
+// Counter 记录尚未写回的浏览次数，同一访问者在去重窗口内重复浏览同一篇博文只计一次
+// 去重记录只保存在当前进程中，多副本部署时同一访问者访问不同副本会分别计数
+type Counter struct {
+	mu      sync.Mutex
+	window  time.Duration
+	pending map[string]int64
+	seen    map[viewKey]time.Time
+}
+
+func New(window time.Duration) *Counter {
+	return &Counter{
+		window:  window,
+		pending: make(map[string]int64),
+		seen:    make(map[viewKey]time.Time),
+	}
+}
+
+// Add 记录viewer对博文的一次浏览，返回是否计数，viewer为空时不去重
+func (c *Counter) Add(postID, viewer string, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if viewer != "" {
+		key := viewKey{postID: postID, viewer: viewer}
+		if last, ok := c.seen[key]; ok && now.Sub(last) < c.window {
+			return false
+		}
+		c.seen[key] = now
+	}
+	c.pending[postID]++
+	return true
+}
+
+// Pending 返回博文尚未写回的浏览次数
+func (c *Counter) Pending(postID string) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.pending[postID]
+}
+
+// Drain 取出所有尚未写回的浏览次数，同时清理已经过期的去重记录
+func (c *Counter) Drain(now time.Time) map[string]int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, last := range c.seen {
+		if now.Sub(last) >= c.window {
+			delete(c.seen, key)
+		}
+	}
+	counts := c.pending
+	c.pending = make(map[string]int64)
+	return counts
+}
+
+// Restore 将写回失败的浏览次数放回，在下一次写回时重试
+func (c *Counter) Restore(counts map[string]int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for postID, n := range counts {
+		c.pending[postID] += n
+	}
+}
@@ -0,0 +1,43 @@
+package viewcount
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := New(time.Minute)
+
+	assert.True(t, c.Add("post-a", "user-1", now))
+	assert.False(t, c.Add("post-a", "user-1", now.Add(30*time.Second)))
+	assert.True(t, c.Add("post-b", "user-1", now))
+	assert.True(t, c.Add("post-a", "ip:10.0.0.1", now))
+	// 去重窗口过后再次计数
+	assert.True(t, c.Add("post-a", "user-1", now.Add(time.Minute)))
+	// 没有访问者信息时不去重
+	assert.True(t, c.Add("post-a", "", now))
+	assert.True(t, c.Add("post-a", "", now))
+	assert.Equal(t, int64(5), c.Pending("post-a"))
+
+	counts := c.Drain(now.Add(time.Minute))
+	assert.Equal(t, map[string]int64{"post-a": 5, "post-b": 1}, counts)
+	assert.Zero(t, c.Pending("post-a"))
+
+	c.Restore(map[string]int64{"post-a": 2})
+	c.Add("post-a", "user-2", now)
+	assert.Equal(t, map[string]int64{"post-a": 3}, c.Drain(now))
+}
+
+func TestDrainPrunesSeen(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := New(time.Minute)
+	c.Add("post-a", "user-1", now)
+	c.Add("post-a", "user-2", now.Add(time.Minute))
+
+	c.Drain(now.Add(90 * time.Second))
+	assert.Len(t, c.seen, 1)
+	assert.True(t, c.Add("post-a", "user-1", now.Add(90*time.Second)))
+}
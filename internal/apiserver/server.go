@@ -13,13 +13,19 @@ package apiserver
 import (
 	"context"
 	"github.com/glebarez/sqlite"
+	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/ArthurWang23/miniblog/internal/apiserver/biz"
 	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/blob"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/moderation"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/scheduler"
 	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/validation"
 	"github.com/ArthurWang23/miniblog/internal/apiserver/store"
 	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
@@ -56,11 +62,25 @@ type Config struct {
 	MySQLOptions      *genericoptions.MySQLOptions
 	TLSOptions        *genericoptions.TLSOptions
 	EnableMemoryStore bool
+	// PublishInterval 检查并发布到期定时博文的时间间隔
+	PublishInterval time.Duration
+	// PostRetention 已删除的博文在回收站中的保留时间，超过后被彻底删除
+	PostRetention time.Duration
+	// MediaDir 保存上传文件的本地目录
+	MediaDir string
+	// MediaMaxSize 上传文件的最大字节数
+	MediaMaxSize int64
+	// Moderation 创建和更新博文时的内容审核规则，为nil时不审核
+	Moderation *moderation.Options
+	// TrustedProxies 可信代理的IP或CIDR，只信任来自这些地址的X-Forwarded-For
+	TrustedProxies []string
 }
 
 // 根据ServerMode决定要启动的服务器类型
 type UnionServer struct {
 	srv server.Server
+	// 运行在服务进程内的后台任务
+	scheduler *scheduler.Scheduler
 }
 
 type ServerConfig struct {
@@ -84,16 +104,13 @@ func (cfg *Config) NewUnionServer() (*UnionServer, error) {
 	log.Infow("Initializing federation server", "server-mode", cfg.ServerMode)
 
 	// 创建服务配置，这些配置可用来创建服务器
-	srv, err := InitializeWebServer(cfg)
-	if err != nil {
-		return nil, err
-	}
-	return &UnionServer{srv: srv}, nil
+	return InitializeWebServer(cfg)
 }
 
 // 启动服务并优雅关闭
 func (s *UnionServer) Run() error {
 	go s.srv.RunOrDie()
+	s.scheduler.Start()
 
 	// 执行kill时默认发送SIGTERM
 	// 使用kill -2 发送SIGINT（如Ctrl+C）
@@ -114,6 +131,7 @@ func (s *UnionServer) Run() error {
 
 	// 先关闭依赖的服务，再关闭被依赖的服务
 	s.srv.GracefulStop(ctx)
+	s.scheduler.Stop(ctx)
 
 	log.Infow("Server exited")
 	return nil
@@ -136,10 +154,17 @@ func (cfg *Config) NewDB() (*gorm.DB, error) {
 		return nil, err
 	}
 	// 自动迁移数据库结构
-	if err := db.AutoMigrate(&model.UserM{}, &model.PostM{}, &model.CasbinRuleM{}); err != nil {
+	if err := db.AutoMigrate(&model.UserM{}, &model.PostM{}, &model.TagM{}, &model.PostTagM{}, &model.CommentM{}, &model.PostLikeM{}, &model.PostPinM{}, &model.BookmarkM{}, &model.FollowM{}, &model.NotificationM{}, &model.PostRevisionM{}, &model.PostSlugM{}, &model.MediaM{}, &model.SeriesM{}, &model.SeriesPostM{}, &model.CasbinRuleM{}); err != nil {
 		log.Errorw("Failed to migrate database schema", "err", err)
 		return nil, err
 	}
+	// SQLite 使用 FTS5 虚拟表代替 MySQL 的 FULLTEXT 索引，保证两种存储的检索行为一致
+	for _, stmt := range store.SQLitePostFTSStatements {
+		if err := db.Exec(stmt).Error; err != nil {
+			log.Errorw("Failed to create full-text search table", "err", err)
+			return nil, err
+		}
+	}
 	// 注意：这里仅仅为了实现快速部署，降低学习难度。
 	// 在真实企业开发中，不能再代码中硬编码这些初始化配置，
 	// 尤其是硬编码密码、密钥之类的信息.
@@ -152,6 +177,13 @@ func (cfg *Config) NewDB() (*gorm.DB, error) {
 		{PType: ptr.To("p"), V0: &userR, V1: ptr.To("/v1.MiniBlog/ListUser"), V2: ptr.To("CALL"), V3: ptr.To("deny")},
 		{PType: ptr.To("p"), V0: &userR, V1: ptr.To("/v1/users"), V2: ptr.To("GET"), V3: ptr.To("deny")},
 		{PType: ptr.To("p"), V0: &userR, V1: ptr.To("/v1/users/*"), V2: ptr.To("DELETE"), V3: ptr.To("deny")},
+		{PType: ptr.To("p"), V0: &userR, V1: ptr.To("/v1.MiniBlog/ExportPosts"), V2: ptr.To("CALL"), V3: ptr.To("deny")},
+		{PType: ptr.To("p"), V0: &userR, V1: ptr.To("/v1.MiniBlog/ImportPosts"), V2: ptr.To("CALL"), V3: ptr.To("deny")},
+		{PType: ptr.To("p"), V0: &userR, V1: ptr.To("/v1/admin/*"), V2: ptr.To("GET"), V3: ptr.To("deny")},
+		{PType: ptr.To("p"), V0: &userR, V1: ptr.To("/v1/admin/*"), V2: ptr.To("POST"), V3: ptr.To("deny")},
+		{PType: ptr.To("p"), V0: &userR, V1: ptr.To("/v1.MiniBlog/ListModeratedPosts"), V2: ptr.To("CALL"), V3: ptr.To("deny")},
+		{PType: ptr.To("p"), V0: &userR, V1: ptr.To("/v1.MiniBlog/ApproveModeratedPost"), V2: ptr.To("CALL"), V3: ptr.To("deny")},
+		{PType: ptr.To("p"), V0: &userR, V1: ptr.To("/v1.MiniBlog/RejectModeratedPost"), V2: ptr.To("CALL"), V3: ptr.To("deny")},
 	}
 
 	if err := db.Create(&casbinRules).Error; err != nil {
@@ -191,10 +223,96 @@ func ProviderDB(cfg *Config) (*gorm.DB, error) {
 	return cfg.NewDB()
 }
 
+// NewBiz 创建不依赖服务器的业务层实例，供命令行工具直接操作数据，不进行权限校验
+func (cfg *Config) NewBiz() (biz.IBiz, error) {
+	db, err := cfg.NewDB()
+	if err != nil {
+		return nil, err
+	}
+	blobs, err := NewBlobStore(cfg)
+	if err != nil {
+		return nil, err
+	}
+	moderator, err := NewModerator(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return biz.NewBiz(store.NewStore(db), nil, blobs, cfg.MediaMaxSize, moderator), nil
+}
+
+// 本地对象存储中文件的访问路径前缀
+const mediaURLPrefix = "/media"
+
+// NewBlobStore 创建保存上传文件的对象存储，目前使用本地文件系统
+func NewBlobStore(cfg *Config) (blob.Store, error) {
+	return blob.NewLocal(cfg.MediaDir, mediaURLPrefix)
+}
+
+// NewModerator 根据配置创建博文内容审核器
+func NewModerator(cfg *Config) (*moderation.Moderator, error) {
+	return cfg.Moderation.NewModerator()
+}
+
+// serveMedia 返回本地对象存储中的文件，文件按内容摘要命名，内容不会变化，可以长期缓存
+func serveMedia(dir string, w http.ResponseWriter, r *http.Request, key string) {
+	if key == "" || key != filepath.Base(key) || strings.HasPrefix(key, ".") {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	http.ServeFile(w, r, filepath.Join(dir, key))
+}
+
+const (
+	// 检查并彻底删除超过保留期限的博文的时间间隔
+	purgeInterval = time.Hour
+	// 将内存中累计的浏览次数写回数据库的时间间隔
+	viewFlushInterval = 10 * time.Second
+)
+
+// NewScheduler 创建后台任务调度器，负责发布到期的定时博文、清理回收站和写回浏览次数
+func NewScheduler(cfg *Config, biz biz.IBiz) *scheduler.Scheduler {
+	return scheduler.New(
+		scheduler.Job{
+			Name:     "publish-scheduled-posts",
+			Interval: cfg.PublishInterval,
+			Run: func(ctx context.Context) error {
+				count, err := biz.PostV1().PublishScheduled(ctx, time.Now())
+				if count > 0 {
+					log.Infow("Published scheduled posts", "count", count)
+				}
+				return err
+			},
+		},
+		scheduler.Job{
+			Name:     "purge-deleted-posts",
+			Interval: purgeInterval,
+			Run: func(ctx context.Context) error {
+				count, err := biz.PostV1().PurgeDeleted(ctx, time.Now().Add(-cfg.PostRetention))
+				if count > 0 {
+					log.Infow("Purged deleted posts", "count", count)
+				}
+				return err
+			},
+		},
+		scheduler.Job{
+			Name:     "flush-post-views",
+			Interval: viewFlushInterval,
+			// 服务关闭时写回剩余的浏览次数，调度器在服务器GracefulStop之后停止，不会遗漏处理中的请求
+			RunOnStop: true,
+			Run: func(ctx context.Context) error {
+				_, err := biz.PostV1().FlushViews(ctx)
+				return err
+			},
+		},
+	)
+}
+
 func NewWebServer(serverMode string, serverConfig *ServerConfig) (server.Server, error) {
 	switch serverMode {
 	case GinServerMode:
-		return serverConfig.NewGinServer(), nil
+		return serverConfig.NewGinServer()
 	default:
 		return serverConfig.NewGRPCServerOr()
 	}
@@ -0,0 +1,76 @@
+package store
+
+import (
+	"context"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	genericstore "github.com/ArthurWang23/miniblog/pkg/store"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BookmarkStore interface {
+	Create(ctx context.Context, obj *model.BookmarkM) error
+	Update(ctx context.Context, obj *model.BookmarkM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.BookmarkM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.BookmarkM, error)
+
+	BookmarkExpansion
+}
+
+type BookmarkExpansion interface {
+	// Upsert 依赖(userID, postID)唯一索引保证同一用户对同一博文只收藏一次，已收藏时只更新备注
+	Upsert(ctx context.Context, obj *model.BookmarkM, updateNote bool) error
+	// ListWithPosts 通过一次关联查询返回用户收藏的博文，只包含status状态且未删除的博文，按收藏时间倒序排列
+	ListWithPosts(ctx context.Context, userID string, status int32, opts *where.Options) (int64, []*model.BookmarkM, error)
+}
+
+type bookmarkStore struct {
+	*genericstore.Store[model.BookmarkM]
+
+	store *datastore
+}
+
+var _ BookmarkStore = (*bookmarkStore)(nil)
+
+func newBookmarkStore(store *datastore) *bookmarkStore {
+	return &bookmarkStore{
+		Store: genericstore.NewStore[model.BookmarkM](store, NewLogger()),
+		store: store,
+	}
+}
+
+func (s *bookmarkStore) Upsert(ctx context.Context, obj *model.BookmarkM, updateNote bool) error {
+	onConflict := clause.OnConflict{
+		Columns:   []clause.Column{{Name: "userID"}, {Name: "postID"}},
+		DoNothing: true,
+	}
+	if updateNote {
+		onConflict.DoNothing = false
+		onConflict.DoUpdates = clause.AssignmentColumns([]string{"note", "updatedAt"})
+	}
+	if err := s.store.DB(ctx).Clauses(onConflict).Create(obj).Error; err != nil {
+		NewLogger().Error(ctx, err, "Failed to upsert bookmark", "object", obj)
+		return err
+	}
+	return nil
+}
+
+func (s *bookmarkStore) ListWithPosts(ctx context.Context, userID string, status int32, opts *where.Options) (count int64, ret []*model.BookmarkM, err error) {
+	// 关联查询中两张表都有userID列，查询条件需要带上表名
+	db := s.store.DB(ctx).Model(&model.BookmarkM{}).
+		InnerJoins("Post", s.store.DB(ctx).Where(&model.PostM{Status: status})).
+		Where("bookmark.userID = ?", userID).
+		Session(&gorm.Session{})
+	err = opts.Where(db).Order("bookmark.id DESC").Find(&ret).Error
+	if err == nil {
+		err = db.Count(&count).Error
+	}
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to list bookmarks with posts", "userID", userID, "conditions", opts)
+		return 0, nil, err
+	}
+	return count, ret, nil
+}
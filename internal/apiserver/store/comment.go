@@ -0,0 +1,31 @@
+package store
+
+import (
+	"context"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	genericstore "github.com/ArthurWang23/miniblog/pkg/store"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+)
+
+type CommentStore interface {
+	Create(ctx context.Context, obj *model.CommentM) error
+	Update(ctx context.Context, obj *model.CommentM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.CommentM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.CommentM, error)
+
+	CommentExpansion
+}
+
+type CommentExpansion interface{}
+
+type commentStore struct {
+	*genericstore.Store[model.CommentM]
+}
+
+var _ CommentStore = (*commentStore)(nil)
+
+func newCommentStore(store *datastore) *commentStore {
+	return &commentStore{Store: genericstore.NewStore[model.CommentM](store, NewLogger())}
+}
@@ -0,0 +1,88 @@
+package store
+
+import (
+	"context"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	genericstore "github.com/ArthurWang23/miniblog/pkg/store"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type FollowStore interface {
+	Create(ctx context.Context, obj *model.FollowM) error
+	Update(ctx context.Context, obj *model.FollowM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.FollowM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.FollowM, error)
+
+	FollowExpansion
+}
+
+type FollowExpansion interface {
+	// CreateIfNotExist 依赖(followerID, followeeID)唯一索引保证重复关注不会产生多条记录，返回是否新增了关注
+	CreateIfNotExist(ctx context.Context, obj *model.FollowM) (bool, error)
+	// ListFollowers 通过一次关联查询返回关注userID的用户，按关注时间倒序排列
+	ListFollowers(ctx context.Context, userID string, opts *where.Options) (int64, []*model.FollowM, error)
+	// ListFollowing 通过一次关联查询返回userID关注的用户，按关注时间倒序排列
+	ListFollowing(ctx context.Context, userID string, opts *where.Options) (int64, []*model.FollowM, error)
+}
+
+type followStore struct {
+	*genericstore.Store[model.FollowM]
+
+	store *datastore
+}
+
+var _ FollowStore = (*followStore)(nil)
+
+func newFollowStore(store *datastore) *followStore {
+	return &followStore{
+		Store: genericstore.NewStore[model.FollowM](store, NewLogger()),
+		store: store,
+	}
+}
+
+// FollowedBy 返回筛选userID关注的用户所拥有记录的查询条件，可通过where.Options.Q与其他条件组合
+func FollowedBy(userID string) (string, []any) {
+	return "userID IN (SELECT followeeID FROM follow WHERE followerID = ?)", []any{userID}
+}
+
+func (s *followStore) CreateIfNotExist(ctx context.Context, obj *model.FollowM) (bool, error) {
+	onConflict := clause.OnConflict{
+		Columns:   []clause.Column{{Name: "followerID"}, {Name: "followeeID"}},
+		DoNothing: true,
+	}
+	result := s.store.DB(ctx).Clauses(onConflict).Create(obj)
+	if result.Error != nil {
+		NewLogger().Error(ctx, result.Error, "Failed to create follow", "object", obj)
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (s *followStore) ListFollowers(ctx context.Context, userID string, opts *where.Options) (int64, []*model.FollowM, error) {
+	return s.listJoined(ctx, "Follower", "follow.followeeID = ?", userID, opts)
+}
+
+func (s *followStore) ListFollowing(ctx context.Context, userID string, opts *where.Options) (int64, []*model.FollowM, error) {
+	return s.listJoined(ctx, "Followee", "follow.followerID = ?", userID, opts)
+}
+
+// listJoined 关联查询关注关系另一端的用户，已删除的用户不会出现在结果中
+func (s *followStore) listJoined(ctx context.Context, join string, query string, userID string, opts *where.Options) (count int64, ret []*model.FollowM, err error) {
+	db := s.store.DB(ctx).Model(&model.FollowM{}).
+		InnerJoins(join).
+		Where(query, userID).
+		Session(&gorm.Session{})
+	err = opts.Where(db).Order("follow.id DESC").Find(&ret).Error
+	if err == nil {
+		err = db.Count(&count).Error
+	}
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to list follows", "join", join, "userID", userID, "conditions", opts)
+		return 0, nil, err
+	}
+	return count, ret, nil
+}
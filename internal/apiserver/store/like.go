@@ -0,0 +1,76 @@
+package store
+
+import (
+	"context"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	genericstore "github.com/ArthurWang23/miniblog/pkg/store"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+	"gorm.io/gorm/clause"
+)
+
+type LikeStore interface {
+	Create(ctx context.Context, obj *model.PostLikeM) error
+	Update(ctx context.Context, obj *model.PostLikeM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.PostLikeM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostLikeM, error)
+
+	LikeExpansion
+}
+
+type LikeExpansion interface {
+	// CreateIfNotExist 依赖(postID, userID)唯一索引保证同一用户对同一博文只点赞一次，重复点赞不报错，返回是否新增了点赞
+	CreateIfNotExist(ctx context.Context, obj *model.PostLikeM) (bool, error)
+	// CountByPostIDs 使用一条聚合查询统计多篇博文的点赞数，返回以postID为键的映射
+	CountByPostIDs(ctx context.Context, postIDs []string) (map[string]int64, error)
+}
+
+type likeStore struct {
+	*genericstore.Store[model.PostLikeM]
+
+	store *datastore
+}
+
+var _ LikeStore = (*likeStore)(nil)
+
+func newLikeStore(store *datastore) *likeStore {
+	return &likeStore{
+		Store: genericstore.NewStore[model.PostLikeM](store, NewLogger()),
+		store: store,
+	}
+}
+
+func (s *likeStore) CreateIfNotExist(ctx context.Context, obj *model.PostLikeM) (bool, error) {
+	result := s.store.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(obj)
+	if result.Error != nil {
+		NewLogger().Error(ctx, result.Error, "Failed to insert post like into database", "object", obj)
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (s *likeStore) CountByPostIDs(ctx context.Context, postIDs []string) (map[string]int64, error) {
+	ret := make(map[string]int64, len(postIDs))
+	if len(postIDs) == 0 {
+		return ret, nil
+	}
+
+	var rows []struct {
+		PostID string `gorm:"column:postID"`
+		Count  int64  `gorm:"column:count"`
+	}
+	err := s.store.DB(ctx).Model(&model.PostLikeM{}).
+		Select("postID, COUNT(*) AS count").
+		Where("postID IN ?", postIDs).
+		Group("postID").
+		Scan(&rows).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to count post likes", "postIDs", postIDs)
+		return nil, err
+	}
+	for _, row := range rows {
+		ret[row.PostID] = row.Count
+	}
+	return ret, nil
+}
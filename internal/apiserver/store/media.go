@@ -0,0 +1,31 @@
+package store
+
+import (
+	"context"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	genericstore "github.com/ArthurWang23/miniblog/pkg/store"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+)
+
+type MediaStore interface {
+	Create(ctx context.Context, obj *model.MediaM) error
+	Update(ctx context.Context, obj *model.MediaM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.MediaM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.MediaM, error)
+
+	MediaExpansion
+}
+
+type MediaExpansion interface{}
+
+type mediaStore struct {
+	*genericstore.Store[model.MediaM]
+}
+
+var _ MediaStore = (*mediaStore)(nil)
+
+func newMediaStore(store *datastore) *mediaStore {
+	return &mediaStore{Store: genericstore.NewStore[model.MediaM](store, NewLogger())}
+}
@@ -0,0 +1,61 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	genericstore "github.com/ArthurWang23/miniblog/pkg/store"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+)
+
+type NotificationStore interface {
+	Create(ctx context.Context, obj *model.NotificationM) error
+	Update(ctx context.Context, obj *model.NotificationM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.NotificationM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.NotificationM, error)
+
+	NotificationExpansion
+}
+
+type NotificationExpansion interface {
+	// MarkRead 将符合opts条件的未读通知标记为已读，返回标记的通知数
+	MarkRead(ctx context.Context, opts *where.Options, readAt time.Time) (int64, error)
+	// CountUnread 统计用户的未读通知数
+	CountUnread(ctx context.Context, userID string) (int64, error)
+}
+
+type notificationStore struct {
+	*genericstore.Store[model.NotificationM]
+
+	store *datastore
+}
+
+var _ NotificationStore = (*notificationStore)(nil)
+
+func newNotificationStore(store *datastore) *notificationStore {
+	return &notificationStore{
+		Store: genericstore.NewStore[model.NotificationM](store, NewLogger()),
+		store: store,
+	}
+}
+
+func (s *notificationStore) MarkRead(ctx context.Context, opts *where.Options, readAt time.Time) (int64, error) {
+	result := s.store.DB(ctx, opts).Model(&model.NotificationM{}).Where("readAt IS NULL").Update("readAt", readAt)
+	if result.Error != nil {
+		NewLogger().Error(ctx, result.Error, "Failed to mark notifications as read", "conditions", opts)
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+func (s *notificationStore) CountUnread(ctx context.Context, userID string) (int64, error) {
+	var count int64
+	err := s.store.DB(ctx).Model(&model.NotificationM{}).Where("userID = ? AND readAt IS NULL", userID).Count(&count).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to count unread notifications", "userID", userID)
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,48 @@
+package store
+
+import (
+	"context"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	genericstore "github.com/ArthurWang23/miniblog/pkg/store"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+)
+
+type PinStore interface {
+	Create(ctx context.Context, obj *model.PostPinM) error
+	Update(ctx context.Context, obj *model.PostPinM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.PostPinM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostPinM, error)
+
+	PinExpansion
+}
+
+type PinExpansion interface {
+	// ListByUser 按置顶位置返回用户置顶的全部博文
+	ListByUser(ctx context.Context, userID string) ([]*model.PostPinM, error)
+}
+
+type pinStore struct {
+	*genericstore.Store[model.PostPinM]
+
+	store *datastore
+}
+
+var _ PinStore = (*pinStore)(nil)
+
+func newPinStore(store *datastore) *pinStore {
+	return &pinStore{
+		Store: genericstore.NewStore[model.PostPinM](store, NewLogger()),
+		store: store,
+	}
+}
+
+func (s *pinStore) ListByUser(ctx context.Context, userID string) ([]*model.PostPinM, error) {
+	var ret []*model.PostPinM
+	if err := s.store.DB(ctx).Where("userID = ?", userID).Order("position, id").Find(&ret).Error; err != nil {
+		NewLogger().Error(ctx, err, "Failed to list pinned posts", "userID", userID)
+		return nil, err
+	}
+	return ret, nil
+}
@@ -2,18 +2,24 @@ package store
 
 import (
 	"context"
-	"errors"
+	"strings"
+	"unicode/utf8"
+
 	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
 	"github.com/ArthurWang23/miniblog/internal/pkg/errno"
-	"github.com/ArthurWang23/miniblog/internal/pkg/log"
 	genericstore "github.com/ArthurWang23/miniblog/pkg/store"
 	"github.com/ArthurWang23/miniblog/pkg/store/where"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PostStore interface {
 	Create(ctx context.Context, obj *model.PostM) error
+	// Update 只有数据库中博文的版本号与obj.Version一致时才会保存，保存后版本号加1
+	// 博文已被其他请求修改时返回errno.ErrPostVersionConflict
 	Update(ctx context.Context, obj *model.PostM) error
+	// UpdateColumns 与Update相同，但只更新columns指定的列
+	UpdateColumns(ctx context.Context, obj *model.PostM, columns ...string) error
 	Delete(ctx context.Context, opts *where.Options) error
 	Get(ctx context.Context, opts *where.Options) (*model.PostM, error)
 	List(ctx context.Context, opts *where.Options) (int64, []*model.PostM, error)
@@ -21,14 +27,189 @@ type PostStore interface {
 	PostExpansion
 }
 
-type PostExpansion interface{}
+type PostExpansion interface {
+	// Search 根据关键词全文检索博文，结果按相关度从高到低排序
+	Search(ctx context.Context, terms []string, opts *where.Options) (int64, []*model.PostM, error)
+	// GetUnscoped 与Get相同，但已删除的博文也会被查询到
+	GetUnscoped(ctx context.Context, opts *where.Options) (*model.PostM, error)
+	// ListDeleted 查询已删除的博文，按删除时间倒序排列
+	ListDeleted(ctx context.Context, opts *where.Options) (int64, []*model.PostM, error)
+	// Restore 恢复已删除的博文，返回恢复的博文数
+	Restore(ctx context.Context, opts *where.Options) (int64, error)
+	// Purge 彻底删除博文，包括已删除的博文
+	Purge(ctx context.Context, opts *where.Options) error
+	// AddViews 增加博文的浏览次数，不修改博文的更新时间
+	AddViews(ctx context.Context, postID string, n int64) error
+}
 
 type postStore struct {
 	*genericstore.Store[model.PostM]
+
+	store *datastore
 }
 
 var _ PostStore = (*postStore)(nil)
 
 func newPostStore(store *datastore) *postStore {
-	return &postStore{Store: genericstore.NewStore[model.PostM](store, NewLogger())}
+	return &postStore{
+		Store: genericstore.NewStore[model.PostM](store, NewLogger()),
+		store: store,
+	}
+}
+
+// SQLite 不支持 FULLTEXT 索引，内存模式下使用 FTS5 虚拟表 post_fts 实现全文检索
+// post_fts 是 post 表的外部内容表，通过触发器与 post 表保持同步
+// 使用 trigram 分词器，与 MySQL 的 ngram 分词器一样按子串匹配，不依赖空格分词，中文也能检索
+var SQLitePostFTSStatements = []string{
+	`CREATE VIRTUAL TABLE IF NOT EXISTS post_fts USING fts5(title, content, content='post', content_rowid='id', tokenize='trigram')`,
+	`CREATE TRIGGER IF NOT EXISTS post_fts_ai AFTER INSERT ON post BEGIN
+		INSERT INTO post_fts(rowid, title, content) VALUES (new.id, new.title, new.content);
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS post_fts_ad AFTER DELETE ON post BEGIN
+		INSERT INTO post_fts(post_fts, rowid, title, content) VALUES ('delete', old.id, old.title, old.content);
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS post_fts_au AFTER UPDATE ON post BEGIN
+		INSERT INTO post_fts(post_fts, rowid, title, content) VALUES ('delete', old.id, old.title, old.content);
+		INSERT INTO post_fts(rowid, title, content) VALUES (new.id, new.title, new.content);
+	END`,
+}
+
+// trigram 分词器无法匹配少于3个字符的关键词，这类关键词改用 LIKE 匹配
+const sqliteTrigramSize = 3
+
+// Search 要求标题或正文包含全部关键词，匹配不区分大小写
+// MySQL 的 FULLTEXT 索引使用 ngram 分词器（ngram_token_size 默认为2）并关闭停用词，
+// SQLite 使用 trigram 分词器，两者都按子串匹配，少于2个字符的关键词由 search.Terms 过滤
+func (s *postStore) Search(ctx context.Context, terms []string, opts *where.Options) (count int64, ret []*model.PostM, err error) {
+	if len(terms) == 0 {
+		return 0, nil, nil
+	}
+
+	db := s.store.DB(ctx, opts).Model(&model.PostM{})
+	var rank clause.Expression
+	switch db.Dialector.Name() {
+	case "sqlite":
+		quoted := make([]string, 0, len(terms))
+		for _, term := range terms {
+			if utf8.RuneCountInString(term) < sqliteTrigramSize {
+				pattern := "%" + term + "%"
+				db = db.Where("(post.title LIKE ? OR post.content LIKE ?)", pattern, pattern)
+				continue
+			}
+			quoted = append(quoted, quotePhrase(term))
+		}
+		rank = clause.Expr{SQL: "post.id DESC", WithoutParentheses: true}
+		if len(quoted) > 0 {
+			db = db.Joins("JOIN post_fts ON post_fts.rowid = post.id").Where("post_fts MATCH ?", strings.Join(quoted, " "))
+			// bm25 越小表示相关度越高
+			rank = clause.Expr{SQL: "bm25(post_fts), post.id DESC", WithoutParentheses: true}
+		}
+	default:
+		// 每个关键词都作为短语并要求全部命中，ngram 分词器会把短语拆成连续的2字符分词，等价于子串匹配
+		quoted := make([]string, 0, len(terms))
+		for _, term := range terms {
+			quoted = append(quoted, "+"+quotePhrase(term))
+		}
+		query := strings.Join(quoted, " ")
+		db = db.Where("MATCH(title, content) AGAINST (? IN BOOLEAN MODE)", query)
+		rank = clause.Expr{SQL: "MATCH(title, content) AGAINST (? IN BOOLEAN MODE) DESC, post.id DESC", Vars: []any{query}, WithoutParentheses: true}
+	}
+	db = db.Session(&gorm.Session{})
+
+	err = db.Select("post.*").Clauses(clause.OrderBy{Expression: rank}).Find(&ret).Error
+	if err == nil {
+		err = db.Offset(-1).Limit(-1).Count(&count).Error
+	}
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to search posts from database", "terms", terms, "conditions", opts)
+		return 0, nil, err
+	}
+	return count, ret, nil
+}
+
+// TitleContains 返回筛选标题包含title的博文的查询条件，可通过where.Options.Q与其他条件组合
+// title中的通配符按普通字符匹配
+func TitleContains(title string) (string, []any) {
+	escaped := strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(title)
+	return "title LIKE ? ESCAPE '!'", []any{"%" + escaped + "%"}
+}
+
+// quotePhrase 将关键词转换为全文检索的短语，避免关键词中的字符被当作检索语法
+func quotePhrase(term string) string {
+	return `"` + strings.ReplaceAll(term, `"`, `""`) + `"`
+}
+
+func (s *postStore) GetUnscoped(ctx context.Context, opts *where.Options) (*model.PostM, error) {
+	var post model.PostM
+	if err := s.store.DB(ctx, opts).Unscoped().First(&post).Error; err != nil {
+		NewLogger().Error(ctx, err, "Failed to retrieve post from database", "conditions", opts)
+		return nil, err
+	}
+	return &post, nil
+}
+
+func (s *postStore) ListDeleted(ctx context.Context, opts *where.Options) (count int64, ret []*model.PostM, err error) {
+	db := s.store.DB(ctx, opts).Unscoped().Model(&model.PostM{}).Where("deletedAt IS NOT NULL").Session(&gorm.Session{})
+	err = db.Order("deletedAt DESC, id DESC").Find(&ret).Error
+	if err == nil {
+		err = db.Offset(-1).Limit(-1).Count(&count).Error
+	}
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to list deleted posts from database", "conditions", opts)
+		return 0, nil, err
+	}
+	return count, ret, nil
+}
+
+func (s *postStore) Restore(ctx context.Context, opts *where.Options) (int64, error) {
+	result := s.store.DB(ctx, opts).Unscoped().Model(&model.PostM{}).Where("deletedAt IS NOT NULL").Update("deletedAt", nil)
+	if result.Error != nil {
+		NewLogger().Error(ctx, result.Error, "Failed to restore posts", "conditions", opts)
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+func (s *postStore) Purge(ctx context.Context, opts *where.Options) error {
+	if err := s.store.DB(ctx, opts).Unscoped().Delete(&model.PostM{}).Error; err != nil {
+		NewLogger().Error(ctx, err, "Failed to purge posts", "conditions", opts)
+		return err
+	}
+	return nil
+}
+
+func (s *postStore) Update(ctx context.Context, obj *model.PostM) error {
+	return s.update(ctx, obj, "*")
+}
+
+func (s *postStore) UpdateColumns(ctx context.Context, obj *model.PostM, columns ...string) error {
+	return s.update(ctx, obj, columns...)
+}
+
+// update 以obj.Version作为条件更新博文，同时将版本号加1
+func (s *postStore) update(ctx context.Context, obj *model.PostM, columns ...string) error {
+	version := obj.Version
+	obj.Version++
+	result := s.store.DB(ctx).Model(obj).Select(append(columns, "version")).Where("version = ?", version).Updates(obj)
+	if result.Error != nil {
+		obj.Version = version
+		NewLogger().Error(ctx, result.Error, "Failed to update object in database", "postID", obj.PostID, "columns", columns)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		obj.Version = version
+		return errno.ErrPostVersionConflict
+	}
+	return nil
+}
+
+func (s *postStore) AddViews(ctx context.Context, postID string, n int64) error {
+	// viewCount只允许在创建时写入，Update保存博文时不会覆盖并发写回的浏览次数，因此这里直接执行UPDATE语句
+	// 显式将updatedAt设置为原值，避免MySQL的ON UPDATE current_timestamp()修改更新时间
+	sql := "UPDATE " + model.TableNamePostM + " SET viewCount = viewCount + ?, updatedAt = updatedAt WHERE postID = ?"
+	if err := s.store.DB(ctx).Exec(sql, n, postID).Error; err != nil {
+		NewLogger().Error(ctx, err, "Failed to add post views", "postID", postID, "views", n)
+		return err
+	}
+	return nil
 }
@@ -0,0 +1,84 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/search"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store/storetest"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+)
+
+func TestPostSearch(t *testing.T) {
+	_, st := storetest.New(t)
+	user := storetest.Users(t, 1)[0]
+	ctx := storetest.Context(user)
+
+	titles := []string{"Learning Golang", "我的博客系统", "Release notes"}
+	for _, title := range titles {
+		require.NoError(t, st.Post().Create(ctx, &model.PostM{UserID: user.UserID, Title: title, Content: "content"}))
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		// 按子串匹配，不区分大小写
+		{query: "LANG", want: []string{"Learning Golang"}},
+		// 少于3个字符的关键词
+		{query: "go", want: []string{"Learning Golang"}},
+		// 中文不依赖空格分词
+		{query: "博客", want: []string{"我的博客系统"}},
+		{query: "博客系统", want: []string{"我的博客系统"}},
+		// 要求全部关键词命中
+		{query: "golang 博客", want: nil},
+		{query: "content", want: titles},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			count, posts, err := st.Post().Search(ctx, search.Terms(tt.query), where.T(ctx))
+			require.NoError(t, err)
+			got := make([]string, 0, len(posts))
+			for _, post := range posts {
+				got = append(got, post.Title)
+			}
+			assert.ElementsMatch(t, tt.want, got)
+			assert.EqualValues(t, len(tt.want), count)
+		})
+	}
+
+	// 关键词只能检索到自己的博文
+	other := storetest.Users(t, 1)[0]
+	_, posts, err := st.Post().Search(storetest.Context(other), []string{"golang"}, where.T(storetest.Context(other)))
+	require.NoError(t, err)
+	assert.Empty(t, posts)
+}
+
+func TestTitleContains(t *testing.T) {
+	_, st := storetest.New(t)
+	user := storetest.Users(t, 1)[0]
+	ctx := storetest.Context(user)
+
+	for _, title := range []string{"50% off", "500 off", "a_b", "axb"} {
+		require.NoError(t, st.Post().Create(ctx, &model.PostM{UserID: user.UserID, Title: title, Content: "c"}))
+	}
+
+	for title, want := range map[string][]string{
+		"0%":  {"50% off"},
+		"a_b": {"a_b"},
+		"OFF": {"50% off", "500 off"},
+	} {
+		query, args := store.TitleContains(title)
+		_, posts, err := st.Post().List(ctx, where.T(ctx).Q(query, args...))
+		require.NoError(t, err)
+		got := make([]string, 0, len(posts))
+		for _, post := range posts {
+			got = append(got, post.Title)
+		}
+		assert.ElementsMatch(t, want, got, title)
+	}
+}
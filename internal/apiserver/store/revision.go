@@ -0,0 +1,52 @@
+package store
+
+import (
+	"context"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	genericstore "github.com/ArthurWang23/miniblog/pkg/store"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+)
+
+type RevisionStore interface {
+	Create(ctx context.Context, obj *model.PostRevisionM) error
+	Update(ctx context.Context, obj *model.PostRevisionM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.PostRevisionM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostRevisionM, error)
+
+	RevisionExpansion
+}
+
+type RevisionExpansion interface {
+	// NextRevision 返回博文下一个版本号，并发写入时由(postID, revision)唯一索引兜底
+	NextRevision(ctx context.Context, postID string) (int64, error)
+}
+
+type revisionStore struct {
+	*genericstore.Store[model.PostRevisionM]
+
+	store *datastore
+}
+
+var _ RevisionStore = (*revisionStore)(nil)
+
+func newRevisionStore(store *datastore) *revisionStore {
+	return &revisionStore{
+		Store: genericstore.NewStore[model.PostRevisionM](store, NewLogger()),
+		store: store,
+	}
+}
+
+func (s *revisionStore) NextRevision(ctx context.Context, postID string) (int64, error) {
+	var latest int64
+	err := s.store.DB(ctx).Model(&model.PostRevisionM{}).
+		Where("postID = ?", postID).
+		Select("COALESCE(MAX(revision), 0)").
+		Scan(&latest).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to get latest post revision", "postID", postID)
+		return 0, err
+	}
+	return latest + 1, nil
+}
@@ -0,0 +1,119 @@
+package store
+
+import (
+	"context"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	genericstore "github.com/ArthurWang23/miniblog/pkg/store"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+	"gorm.io/gorm"
+)
+
+type SeriesStore interface {
+	Create(ctx context.Context, obj *model.SeriesM) error
+	Update(ctx context.Context, obj *model.SeriesM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.SeriesM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.SeriesM, error)
+
+	SeriesExpansion
+}
+
+type SeriesExpansion interface {
+	// AddPost 将博文加入系列，依赖postID唯一索引保证一篇博文只属于一个系列
+	AddPost(ctx context.Context, obj *model.SeriesPostM) error
+	// NextPosition 返回追加到系列末尾的博文应使用的位置
+	NextPosition(ctx context.Context, seriesID string) (int64, error)
+	// ListPosts 按位置顺序返回系列中未删除的博文
+	ListPosts(ctx context.Context, seriesID string) ([]*model.PostM, error)
+	// GetPostSeries 返回博文所属的系列关系，博文不属于任何系列时返回gorm.ErrRecordNotFound
+	GetPostSeries(ctx context.Context, postID string) (*model.SeriesPostM, error)
+	// SetPosition 修改博文在系列中的位置
+	SetPosition(ctx context.Context, seriesID string, postID string, position int64) error
+	// DeletePosts 将博文从所属的系列中移除
+	DeletePosts(ctx context.Context, postIDs []string) error
+}
+
+type seriesStore struct {
+	*genericstore.Store[model.SeriesM]
+
+	store *datastore
+}
+
+var _ SeriesStore = (*seriesStore)(nil)
+
+func newSeriesStore(store *datastore) *seriesStore {
+	return &seriesStore{
+		Store: genericstore.NewStore[model.SeriesM](store, NewLogger()),
+		store: store,
+	}
+}
+
+func (s *seriesStore) AddPost(ctx context.Context, obj *model.SeriesPostM) error {
+	if err := s.store.DB(ctx).Create(obj).Error; err != nil {
+		NewLogger().Error(ctx, err, "Failed to add post to series", "seriesID", obj.SeriesID, "postID", obj.PostID)
+		return err
+	}
+	return nil
+}
+
+func (s *seriesStore) NextPosition(ctx context.Context, seriesID string) (int64, error) {
+	var position int64
+	err := s.store.DB(ctx).Model(&model.SeriesPostM{}).Where("seriesID = ?", seriesID).
+		Select("COALESCE(MAX(position), 0) + 1").Scan(&position).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to get next series position", "seriesID", seriesID)
+		return 0, err
+	}
+	return position, nil
+}
+
+func (s *seriesStore) ListPosts(ctx context.Context, seriesID string) ([]*model.PostM, error) {
+	var ret []*model.PostM
+	err := s.store.DB(ctx).Model(&model.PostM{}).
+		Joins("JOIN series_post ON series_post.postID = post.postID").
+		Where("series_post.seriesID = ?", seriesID).
+		Order("series_post.position, series_post.id").
+		Find(&ret).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to list series posts", "seriesID", seriesID)
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetPostSeries 大多数博文不属于任何系列，使用Find代替First，避免gorm将未找到记录作为错误写入日志
+func (s *seriesStore) GetPostSeries(ctx context.Context, postID string) (*model.SeriesPostM, error) {
+	var ret model.SeriesPostM
+	result := s.store.DB(ctx).Where("postID = ?", postID).Limit(1).Find(&ret)
+	if result.Error != nil {
+		NewLogger().Error(ctx, result.Error, "Failed to retrieve post series from database", "postID", postID)
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &ret, nil
+}
+
+func (s *seriesStore) SetPosition(ctx context.Context, seriesID string, postID string, position int64) error {
+	err := s.store.DB(ctx).Model(&model.SeriesPostM{}).
+		Where("seriesID = ? AND postID = ?", seriesID, postID).
+		Update("position", position).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to update series post position", "seriesID", seriesID, "postID", postID)
+		return err
+	}
+	return nil
+}
+
+func (s *seriesStore) DeletePosts(ctx context.Context, postIDs []string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+	if err := s.store.DB(ctx).Where("postID IN ?", postIDs).Delete(&model.SeriesPostM{}).Error; err != nil {
+		NewLogger().Error(ctx, err, "Failed to delete series posts", "postIDs", postIDs)
+		return err
+	}
+	return nil
+}
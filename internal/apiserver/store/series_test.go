@@ -0,0 +1,32 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store/storetest"
+)
+
+func TestGetPostSeries(t *testing.T) {
+	_, st := storetest.New(t)
+	user := storetest.Users(t, 1)[0]
+	ctx := storetest.Context(user)
+
+	post := &model.PostM{UserID: user.UserID, Title: "in series", Content: "c"}
+	require.NoError(t, st.Post().Create(ctx, post))
+	_, err := st.Series().GetPostSeries(ctx, post.PostID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	series := &model.SeriesM{UserID: user.UserID, Title: "series"}
+	require.NoError(t, st.Series().Create(ctx, series))
+	require.NoError(t, st.Series().AddPost(ctx, &model.SeriesPostM{SeriesID: series.SeriesID, PostID: post.PostID, Position: 1}))
+
+	got, err := st.Series().GetPostSeries(ctx, post.PostID)
+	require.NoError(t, err)
+	assert.Equal(t, series.SeriesID, got.SeriesID)
+	assert.EqualValues(t, 1, got.Position)
+}
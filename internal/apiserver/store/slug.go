@@ -0,0 +1,31 @@
+package store
+
+import (
+	"context"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	genericstore "github.com/ArthurWang23/miniblog/pkg/store"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+)
+
+type SlugStore interface {
+	Create(ctx context.Context, obj *model.PostSlugM) error
+	Update(ctx context.Context, obj *model.PostSlugM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.PostSlugM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostSlugM, error)
+
+	SlugExpansion
+}
+
+type SlugExpansion interface{}
+
+type slugStore struct {
+	*genericstore.Store[model.PostSlugM]
+}
+
+var _ SlugStore = (*slugStore)(nil)
+
+func newSlugStore(store *datastore) *slugStore {
+	return &slugStore{Store: genericstore.NewStore[model.PostSlugM](store, NewLogger())}
+}
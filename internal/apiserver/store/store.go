@@ -28,6 +28,17 @@ type IStore interface {
 	// User()和Post()分别返回User资源的store层方法和Post资源的store层方法
 	User() UserStore
 	Post() PostStore
+	Tag() TagStore
+	Comment() CommentStore
+	Like() LikeStore
+	Revision() RevisionStore
+	Slug() SlugStore
+	Media() MediaStore
+	Series() SeriesStore
+	Pin() PinStore
+	Bookmark() BookmarkStore
+	Follow() FollowStore
+	Notification() NotificationStore
 
 	// 展示在go中直接与db交互
 	ConcretePost() ConcretePostStore
@@ -85,4 +96,48 @@ func (store *datastore) Post() PostStore {
 	return newPostStore(store)
 }
 
+func (store *datastore) Tag() TagStore {
+	return newTagStore(store)
+}
+
+func (store *datastore) Comment() CommentStore {
+	return newCommentStore(store)
+}
+
+func (store *datastore) Like() LikeStore {
+	return newLikeStore(store)
+}
+
+func (store *datastore) Revision() RevisionStore {
+	return newRevisionStore(store)
+}
+
+func (store *datastore) Slug() SlugStore {
+	return newSlugStore(store)
+}
+
+func (store *datastore) Media() MediaStore {
+	return newMediaStore(store)
+}
+
+func (store *datastore) Series() SeriesStore {
+	return newSeriesStore(store)
+}
+
+func (store *datastore) Pin() PinStore {
+	return newPinStore(store)
+}
+
+func (store *datastore) Bookmark() BookmarkStore {
+	return newBookmarkStore(store)
+}
+
+func (store *datastore) Follow() FollowStore {
+	return newFollowStore(store)
+}
+
+func (store *datastore) Notification() NotificationStore {
+	return newNotificationStore(store)
+}
+
 func (store *datastore) ConcretePost() ConcretePostStore { return newConcretePostStore(store) }
@@ -0,0 +1,90 @@
+// Copyright 2025 ArthurWang &lt;2826979176@qq.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/arthurwang23/miniblog. The professional
+// version of this repository is https://github.com/arthurwang23/miniblog.
+
+// Package storetest 为依赖数据库的测试提供SQLite内存数据库
+package storetest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	"github.com/ArthurWang23/miniblog/internal/apiserver/store"
+	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+)
+
+var (
+	once sync.Once
+	db   *gorm.DB
+	err  error
+	// 用于生成不重复的用户名和手机号
+	seq atomic.Int64
+)
+
+// New 返回初始化好的数据库和Store
+// store.NewStore是全局单例，同一个测试二进制中的所有测试共享同一个数据库，测试数据需要通过Users等函数隔离
+func New(t testing.TB) (*gorm.DB, store.IStore) {
+	t.Helper()
+	once.Do(func() {
+		where.RegisterTenant("userID", contextx.UserID)
+		db, err = open()
+	})
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+	return db, store.NewStore(db)
+}
+
+func open() (*gorm.DB, error) {
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		return nil, err
+	}
+	if err := db.AutoMigrate(&model.UserM{}, &model.PostM{}, &model.TagM{}, &model.PostTagM{}, &model.CommentM{}, &model.PostLikeM{}, &model.PostPinM{}, &model.BookmarkM{}, &model.FollowM{}, &model.NotificationM{}, &model.PostRevisionM{}, &model.PostSlugM{}, &model.MediaM{}, &model.SeriesM{}, &model.SeriesPostM{}); err != nil {
+		return nil, err
+	}
+	for _, stmt := range store.SQLitePostFTSStatements {
+		if err := db.Exec(stmt).Error; err != nil {
+			return nil, err
+		}
+	}
+	return db, nil
+}
+
+// Users 创建n个新用户，用户名在整个测试二进制中唯一
+func Users(t testing.TB, n int) []*model.UserM {
+	t.Helper()
+	db, _ := New(t)
+	users := make([]*model.UserM, 0, n)
+	for range n {
+		i := seq.Add(1)
+		user := &model.UserM{
+			Username: fmt.Sprintf("user%d", i),
+			Password: "miniblog1234",
+			Nickname: fmt.Sprintf("nick%d", i),
+			Email:    fmt.Sprintf("user%d@miniblog.com", i),
+			Phone:    fmt.Sprintf("181%08d", i),
+		}
+		if err := db.Create(user).Error; err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+		users = append(users, user)
+	}
+	return users
+}
+
+// Context 返回以user身份发起请求的上下文
+func Context(user *model.UserM) context.Context {
+	return contextx.WithUsername(contextx.WithUserID(context.Background(), user.UserID), user.Username)
+}
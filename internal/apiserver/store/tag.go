@@ -0,0 +1,153 @@
+package store
+
+import (
+	"context"
+
+	"github.com/ArthurWang23/miniblog/internal/apiserver/model"
+	genericstore "github.com/ArthurWang23/miniblog/pkg/store"
+	"github.com/ArthurWang23/miniblog/pkg/store/where"
+	"gorm.io/gorm/clause"
+)
+
+type TagStore interface {
+	Create(ctx context.Context, obj *model.TagM) error
+	Update(ctx context.Context, obj *model.TagM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.TagM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.TagM, error)
+
+	TagExpansion
+}
+
+type TagExpansion interface {
+	// Ensure 返回指定名称的标签，不存在的标签会被自动创建
+	Ensure(ctx context.Context, names []string) ([]*model.TagM, error)
+	// SetPostTags 使用tagIDs覆盖博文已有的标签
+	SetPostTags(ctx context.Context, postID string, tagIDs []int64) error
+	DeletePostTags(ctx context.Context, postIDs []string) error
+	// ListPostTags 批量查询博文的标签名称，返回以postID为键的映射
+	ListPostTags(ctx context.Context, postIDs []string) (map[string][]string, error)
+	// ListUsage 统计标签被博文引用的次数，不包括已删除的博文，opts作用于post表
+	ListUsage(ctx context.Context, opts *where.Options) ([]*TagUsage, error)
+}
+
+// TagUsage 表示标签及其被引用的次数
+type TagUsage struct {
+	Name  string `gorm:"column:name"`
+	Count int64  `gorm:"column:count"`
+}
+
+type tagStore struct {
+	*genericstore.Store[model.TagM]
+
+	store *datastore
+}
+
+var _ TagStore = (*tagStore)(nil)
+
+func newTagStore(store *datastore) *tagStore {
+	return &tagStore{
+		Store: genericstore.NewStore[model.TagM](store, NewLogger()),
+		store: store,
+	}
+}
+
+// TaggedWith 返回筛选同时包含全部names标签的博文的查询条件，可通过where.Options.Q与其他条件组合
+func TaggedWith(names []string) (string, []any) {
+	query := "postID IN (SELECT post_tag.postID FROM post_tag JOIN tag ON tag.id = post_tag.tagID " +
+		"WHERE tag.name IN ? GROUP BY post_tag.postID HAVING COUNT(DISTINCT post_tag.tagID) = ?)"
+	return query, []any{names, len(names)}
+}
+
+func (s *tagStore) Ensure(ctx context.Context, names []string) ([]*model.TagM, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	tags := make([]*model.TagM, 0, len(names))
+	for _, name := range names {
+		tags = append(tags, &model.TagM{Name: name})
+	}
+	// 并发创建同名标签时依赖唯一索引去重，忽略冲突后再统一查询
+	if err := s.store.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&tags).Error; err != nil {
+		NewLogger().Error(ctx, err, "Failed to create tags", "names", names)
+		return nil, err
+	}
+
+	var ret []*model.TagM
+	if err := s.store.DB(ctx).Where("name IN ?", names).Find(&ret).Error; err != nil {
+		NewLogger().Error(ctx, err, "Failed to list tags", "names", names)
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (s *tagStore) SetPostTags(ctx context.Context, postID string, tagIDs []int64) error {
+	if err := s.DeletePostTags(ctx, []string{postID}); err != nil {
+		return err
+	}
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	postTags := make([]*model.PostTagM, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		postTags = append(postTags, &model.PostTagM{PostID: postID, TagID: tagID})
+	}
+	if err := s.store.DB(ctx).Create(&postTags).Error; err != nil {
+		NewLogger().Error(ctx, err, "Failed to create post tags", "postID", postID, "tagIDs", tagIDs)
+		return err
+	}
+	return nil
+}
+
+func (s *tagStore) DeletePostTags(ctx context.Context, postIDs []string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+	if err := s.store.DB(ctx).Where("postID IN ?", postIDs).Delete(&model.PostTagM{}).Error; err != nil {
+		NewLogger().Error(ctx, err, "Failed to delete post tags", "postIDs", postIDs)
+		return err
+	}
+	return nil
+}
+
+func (s *tagStore) ListPostTags(ctx context.Context, postIDs []string) (map[string][]string, error) {
+	ret := make(map[string][]string, len(postIDs))
+	if len(postIDs) == 0 {
+		return ret, nil
+	}
+
+	var rows []struct {
+		PostID string `gorm:"column:postID"`
+		Name   string `gorm:"column:name"`
+	}
+	err := s.store.DB(ctx).Table(model.TableNamePostTagM).
+		Select("post_tag.postID, tag.name").
+		Joins("JOIN tag ON tag.id = post_tag.tagID").
+		Where("post_tag.postID IN ?", postIDs).
+		Order("tag.name").
+		Scan(&rows).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to list post tags", "postIDs", postIDs)
+		return nil, err
+	}
+	for _, row := range rows {
+		ret[row.PostID] = append(ret[row.PostID], row.Name)
+	}
+	return ret, nil
+}
+
+func (s *tagStore) ListUsage(ctx context.Context, opts *where.Options) (ret []*TagUsage, err error) {
+	err = s.store.DB(ctx, opts).Table(model.TableNamePostTagM).
+		Select("tag.name AS name, COUNT(*) AS count").
+		Joins("JOIN tag ON tag.id = post_tag.tagID").
+		Joins("JOIN post ON post.postID = post_tag.postID AND post.deletedAt IS NULL").
+		Group("tag.id, tag.name").
+		Order("count DESC, tag.name").
+		Scan(&ret).Error
+	if err != nil {
+		NewLogger().Error(ctx, err, "Failed to list tag usage", "conditions", opts)
+	}
+	return
+}
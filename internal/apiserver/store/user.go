@@ -15,6 +15,8 @@ type UserStore interface {
 	// 标准CRUD
 	Create(ctx context.Context, obj *model.UserM) error
 	Update(ctx context.Context, obj *model.UserM) error
+	// 只更新指定的列，用于部分更新
+	UpdateColumns(ctx context.Context, obj *model.UserM, columns ...string) error
 	Delete(ctx context.Context, opts *where.Options) error
 	Get(ctx context.Context, opts *where.Options) (*model.UserM, error)
 	List(ctx context.Context, opts *where.Options) (int64, []*model.UserM, error)
@@ -9,15 +9,18 @@ import (
 	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/validation"
 	"github.com/ArthurWang23/miniblog/internal/apiserver/store"
 	ginmw "github.com/ArthurWang23/miniblog/internal/pkg/middleware/gin"
-	"github.com/ArthurWang23/miniblog/internal/pkg/server"
 	"github.com/ArthurWang23/miniblog/pkg/auth"
 	"github.com/google/wire"
 )
 
 // 通过wire实现依赖注入
-func InitializeWebServer(*Config) (server.Server, error) {
+func InitializeWebServer(*Config) (*UnionServer, error) {
 	wire.Build(
+		wire.Struct(new(UnionServer), "*"),
+		NewScheduler,
 		wire.NewSet(NewWebServer, wire.FieldsOf(new(*Config), "ServerMode")),
+		wire.NewSet(NewBlobStore, wire.FieldsOf(new(*Config), "MediaMaxSize")),
+		NewModerator,
 		wire.Struct(new(ServerConfig), "*"), // 表示注入全部字段
 		wire.NewSet(store.ProviderSet, biz.ProviderSet),
 		ProviderDB,
@@ -10,14 +10,13 @@ import (
 	"github.com/ArthurWang23/miniblog/internal/apiserver/biz"
 	"github.com/ArthurWang23/miniblog/internal/apiserver/pkg/validation"
 	"github.com/ArthurWang23/miniblog/internal/apiserver/store"
-	"github.com/ArthurWang23/miniblog/internal/pkg/server"
 	"github.com/ArthurWang23/miniblog/pkg/auth"
 )
 
 // Injectors from wire.go:
 
 // 通过wire实现依赖注入
-func InitializeWebServer(config *Config) (server.Server, error) {
+func InitializeWebServer(config *Config) (*UnionServer, error) {
 	string2 := config.ServerMode
 	db, err := ProviderDB(config)
 	if err != nil {
@@ -29,7 +28,16 @@ func InitializeWebServer(config *Config) (server.Server, error) {
 	if err != nil {
 		return nil, err
 	}
-	bizBiz := biz.NewBiz(datastore, authz)
+	blobStore, err := NewBlobStore(config)
+	if err != nil {
+		return nil, err
+	}
+	int64_2 := config.MediaMaxSize
+	moderator, err := NewModerator(config)
+	if err != nil {
+		return nil, err
+	}
+	bizBiz := biz.NewBiz(datastore, authz, blobStore, int64_2, moderator)
 	validator := validation.New(datastore)
 	userRetriever := &UserRetriever{
 		store: datastore,
@@ -45,5 +53,10 @@ func InitializeWebServer(config *Config) (server.Server, error) {
 	if err != nil {
 		return nil, err
 	}
-	return serverServer, nil
+	schedulerScheduler := NewScheduler(config, bizBiz)
+	unionServer := &UnionServer{
+		srv:       serverServer,
+		scheduler: schedulerScheduler,
+	}
+	return unionServer, nil
 }
@@ -17,6 +17,8 @@ type (
 	accessTokenKey struct{}
 	// requestIDKey 定义请求ID的上下文键
 	requestIDKey struct{}
+	// clientIPKey 定义客户端IP的上下文键
+	clientIPKey struct{}
 )
 
 // 将userID存放到上下文中
@@ -56,3 +58,12 @@ func AccessToken(ctx context.Context) string {
 	accessToken, _ := ctx.Value(accessTokenKey{}).(string)
 	return accessToken
 }
+
+func WithClientIP(ctx context.Context, clientIP string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, clientIP)
+}
+
+func ClientIP(ctx context.Context) string {
+	clientIP, _ := ctx.Value(clientIPKey{}).(string)
+	return clientIP
+}
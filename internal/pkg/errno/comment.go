@@ -0,0 +1,16 @@
+// Copyright 2025 ArthurWang &lt;2826979176@qq.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/arthurwang23/miniblog. The professional
+// version of this repository is https://github.com/arthurwang23/miniblog.
+
+package errno
+
+import (
+	"net/http"
+
+	"github.com/ArthurWang23/miniblog/pkg/errorsx"
+)
+
+// ErrCommentNotFound 表示未找到指定的评论.
+var ErrCommentNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.CommentNotFound", Message: "Comment not found."}
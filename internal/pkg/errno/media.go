@@ -0,0 +1,19 @@
+// Copyright 2025 ArthurWang &lt;2826979176@qq.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/arthurwang23/miniblog. The professional
+// version of this repository is https://github.com/arthurwang23/miniblog.
+
+package errno
+
+import (
+	"net/http"
+
+	"github.com/ArthurWang23/miniblog/pkg/errorsx"
+)
+
+// ErrMediaTooLarge 表示上传的文件超过了大小限制.
+var ErrMediaTooLarge = &errorsx.ErrorX{Code: http.StatusBadRequest, Reason: "InvalidArgument.MediaTooLarge", Message: "Media file is too large."}
+
+// ErrMediaTypeNotAllowed 表示上传的文件类型不被允许.
+var ErrMediaTypeNotAllowed = &errorsx.ErrorX{Code: http.StatusBadRequest, Reason: "InvalidArgument.MediaTypeNotAllowed", Message: "Media type is not allowed."}
@@ -14,3 +14,21 @@ import (
 
 // ErrPostNotFound 表示未找到指定的博客.
 var ErrPostNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.PostNotFound", Message: "Post not found."}
+
+// ErrPostStatusTransition 表示博文当前状态不允许执行该状态流转.
+var ErrPostStatusTransition = &errorsx.ErrorX{Code: http.StatusBadRequest, Reason: "InvalidArgument.PostStatusTransition", Message: "Post status transition is not allowed."}
+
+// ErrPostRevisionNotFound 表示未找到指定的博文历史版本.
+var ErrPostRevisionNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.PostRevisionNotFound", Message: "Post revision not found."}
+
+// ErrPostVersionConflict 表示博文已被其他请求修改，客户端需要重新读取后再提交.
+var ErrPostVersionConflict = &errorsx.ErrorX{Code: http.StatusConflict, Reason: "Aborted.PostVersionConflict", Message: "Post has been modified by another request."}
+
+// ErrPostRejectedByModeration 表示博文内容未通过审核规则，Message中说明命中的规则.
+var ErrPostRejectedByModeration = &errorsx.ErrorX{Code: http.StatusBadRequest, Reason: "InvalidArgument.PostRejectedByModeration", Message: "Post content is rejected by moderation."}
+
+// ErrTooManyPinnedPosts 表示用户置顶的博文数已达到上限.
+var ErrTooManyPinnedPosts = &errorsx.ErrorX{Code: http.StatusBadRequest, Reason: "InvalidArgument.TooManyPinnedPosts", Message: "Too many pinned posts."}
+
+// ErrPostInTrash 表示博文已被删除到回收站，需要先恢复才能修改.
+var ErrPostInTrash = &errorsx.ErrorX{Code: http.StatusConflict, Reason: "FailedPrecondition.PostInTrash", Message: "Post is in trash, restore it before updating."}
@@ -0,0 +1,19 @@
+// Copyright 2025 ArthurWang &lt;2826979176@qq.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/arthurwang23/miniblog. The professional
+// version of this repository is https://github.com/arthurwang23/miniblog.
+
+package errno
+
+import (
+	"net/http"
+
+	"github.com/ArthurWang23/miniblog/pkg/errorsx"
+)
+
+// ErrSeriesNotFound 表示未找到指定的博文系列.
+var ErrSeriesNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.SeriesNotFound", Message: "Series not found."}
+
+// ErrPostAlreadyInSeries 表示博文已经属于某个系列.
+var ErrPostAlreadyInSeries = &errorsx.ErrorX{Code: http.StatusBadRequest, Reason: "AlreadyExist.PostAlreadyInSeries", Message: "Post already belongs to a series."}
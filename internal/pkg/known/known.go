@@ -23,3 +23,10 @@ const (
 
 	MaxErrGroupConcurrency = 1000
 )
+
+const (
+	// DefaultPublicPageSize 公开列表接口未指定limit时每页返回的条数
+	DefaultPublicPageSize = 20
+	// MaxPublicPageSize 公开列表接口每页最多返回的条数
+	MaxPublicPageSize = 100
+)
@@ -0,0 +1,21 @@
+// Copyright 2025 ArthurWang &lt;2826979176@qq.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/arthurwang23/miniblog. The professional
+// version of this repository is https://github.com/arthurwang23/miniblog.
+
+package gin
+
+import (
+	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
+	"github.com/gin-gonic/gin"
+)
+
+// 将客户端IP注入请求上下文，用于统计浏览次数等需要区分匿名访问者的场景
+func ClientIPMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := contextx.WithClientIP(c.Request.Context(), c.ClientIP())
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
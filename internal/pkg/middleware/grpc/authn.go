@@ -9,6 +9,7 @@ import (
 	"github.com/ArthurWang23/miniblog/internal/pkg/known"
 	"github.com/ArthurWang23/miniblog/internal/pkg/log"
 	"github.com/ArthurWang23/miniblog/pkg/token"
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
 	"google.golang.org/grpc"
 )
 
@@ -20,23 +21,45 @@ type UserRetriever interface {
 // 进行认证
 func AuthnInterceptor(retriever UserRetriever) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-		//解析 JWT token
-		userID, err := token.ParseRequest(ctx)
+		ctx, err := authenticate(ctx, retriever)
 		if err != nil {
-			log.Errorw("Failed to parse request", "err", err)
-			return nil, errno.ErrTokenInvalid.WithMessage(err.Error())
+			return nil, err
 		}
-		log.Debugw("Token parsing successful", "userID", userID)
-		user, err := retriever.GetUser(ctx, userID)
+		return handler(ctx, req)
+	}
+}
+
+// AuthnStreamInterceptor 流式调用的认证拦截器，认证逻辑与AuthnInterceptor相同
+func AuthnStreamInterceptor(retriever UserRetriever) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), retriever)
 		if err != nil {
-			return nil, errno.ErrUnauthenticated.WithMessage(err.Error())
+			return err
 		}
-		// 将用户信息存入上下文
-		ctx = context.WithValue(ctx, known.XUsername, user.Username)
-		ctx = context.WithValue(ctx, known.XUserID, userID)
+		wrapped := middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
 
-		ctx = contextx.WithUserID(ctx, user.UserID)
-		ctx = contextx.WithUsername(ctx, user.Username)
-		return handler(ctx, req)
+// authenticate 解析请求中的token，并将用户信息存入上下文
+func authenticate(ctx context.Context, retriever UserRetriever) (context.Context, error) {
+	//解析 JWT token
+	userID, err := token.ParseRequest(ctx)
+	if err != nil {
+		log.Errorw("Failed to parse request", "err", err)
+		return nil, errno.ErrTokenInvalid.WithMessage(err.Error())
 	}
+	log.Debugw("Token parsing successful", "userID", userID)
+	user, err := retriever.GetUser(ctx, userID)
+	if err != nil {
+		return nil, errno.ErrUnauthenticated.WithMessage(err.Error())
+	}
+	// 将用户信息存入上下文
+	ctx = context.WithValue(ctx, known.XUsername, user.Username)
+	ctx = context.WithValue(ctx, known.XUserID, userID)
+
+	ctx = contextx.WithUserID(ctx, user.UserID)
+	ctx = contextx.WithUsername(ctx, user.Username)
+	return ctx, nil
 }
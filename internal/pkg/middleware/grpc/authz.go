@@ -15,21 +15,37 @@ type Authorizer interface {
 
 func AuthzInterceptor(authorizer Authorizer) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-		subject := contextx.UserID(ctx)
-		object := info.FullMethod
-		action := "CALL"
-
-		log.Debugf("Build authorize context", "subject", subject, "object", object, "action", action)
-
-		if allowed, err := authorizer.Authorize(subject, object, action); err != nil || !allowed {
-			return nil, errno.ErrPermissionDenied.WithMessage(
-				"access denied : subject=%s,object=%s,action=%s,reason=%v",
-				subject,
-				object,
-				action,
-				err,
-			)
+		if err := authorize(ctx, authorizer, info.FullMethod); err != nil {
+			return nil, err
 		}
 		return handler(ctx, req)
 	}
 }
+
+// AuthzStreamInterceptor 流式调用的授权拦截器
+func AuthzStreamInterceptor(authorizer Authorizer) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(ss.Context(), authorizer, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func authorize(ctx context.Context, authorizer Authorizer, object string) error {
+	subject := contextx.UserID(ctx)
+	action := "CALL"
+
+	log.Debugf("Build authorize context", "subject", subject, "object", object, "action", action)
+
+	if allowed, err := authorizer.Authorize(subject, object, action); err != nil || !allowed {
+		return errno.ErrPermissionDenied.WithMessage(
+			"access denied : subject=%s,object=%s,action=%s,reason=%v",
+			subject,
+			object,
+			action,
+			err,
+		)
+	}
+	return nil
+}
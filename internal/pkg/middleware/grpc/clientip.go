@@ -0,0 +1,97 @@
+// Copyright 2025 ArthurWang &lt;2826979176@qq.com>. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file. The original repo for
+// this file is https://github.com/arthurwang23/miniblog. The professional
+// version of this repository is https://github.com/arthurwang23/miniblog.
+
+package grpc
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/ArthurWang23/miniblog/internal/pkg/contextx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ClientIPInterceptor 将客户端IP注入请求上下文
+// 只有对端地址属于trustedProxies（IP或CIDR，例如进程内的grpc-gateway）时才信任x-forwarded-for元数据，
+// 从右向左跳过可信代理，第一个不可信的地址即为客户端IP；否则使用连接的对端地址，避免客户端伪造IP
+func ClientIPInterceptor(trustedProxies ...string) grpc.UnaryServerInterceptor {
+	trusted := parseTrustedProxies(trustedProxies)
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(contextx.WithClientIP(ctx, clientIP(ctx, trusted)), req)
+	}
+}
+
+func clientIP(ctx context.Context, trusted []*net.IPNet) string {
+	remote := peerIP(ctx)
+	if !isTrusted(remote, trusted) {
+		return remote
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	// 多个x-forwarded-for按出现的顺序拼接，右侧是离服务端最近的代理添加的地址
+	var hops []string
+	for _, forwarded := range md.Get("x-forwarded-for") {
+		for _, ip := range strings.Split(forwarded, ",") {
+			hops = append(hops, strings.TrimSpace(ip))
+		}
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		if net.ParseIP(hops[i]) == nil {
+			// 无法解析的地址之前的内容都不可信
+			break
+		}
+		if !isTrusted(hops[i], trusted) {
+			return hops[i]
+		}
+		remote = hops[i]
+	}
+	return remote
+}
+
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+func isTrusted(ip string, trusted []*net.IPNet) bool {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+	for _, network := range trusted {
+		if network.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTrustedProxies 解析IP或CIDR格式的可信代理地址，配置项已经校验过格式，无法解析的地址直接忽略
+func parseTrustedProxies(proxies []string) []*net.IPNet {
+	ret := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			if ip := net.ParseIP(proxy); ip != nil && ip.To4() != nil {
+				proxy += "/32"
+			} else {
+				proxy += "/128"
+			}
+		}
+		if _, network, err := net.ParseCIDR(proxy); err == nil {
+			ret = append(ret, network)
+		}
+	}
+	return ret
+}
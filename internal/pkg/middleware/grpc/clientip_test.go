@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestClientIP(t *testing.T) {
+	trusted := parseTrustedProxies([]string{"127.0.0.1", "10.0.0.0/8"})
+	newContext := func(remote string, forwarded ...string) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(remote), Port: 1234}})
+		if len(forwarded) > 0 {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", forwarded[0]))
+		}
+		return ctx
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{name: "direct", ctx: newContext("1.2.3.4"), want: "1.2.3.4"},
+		// 不可信的对端伪造的x-forwarded-for被忽略
+		{name: "untrusted peer", ctx: newContext("1.2.3.4", "5.6.7.8"), want: "1.2.3.4"},
+		{name: "trusted peer", ctx: newContext("127.0.0.1", "5.6.7.8"), want: "5.6.7.8"},
+		// 客户端自带的x-forwarded-for在左侧，只取最右侧的不可信地址
+		{name: "spoofed hop", ctx: newContext("127.0.0.1", "9.9.9.9, 5.6.7.8"), want: "5.6.7.8"},
+		{name: "chained proxies", ctx: newContext("127.0.0.1", "5.6.7.8, 10.1.2.3"), want: "5.6.7.8"},
+		{name: "all trusted", ctx: newContext("127.0.0.1", "10.1.2.3"), want: "10.1.2.3"},
+		{name: "garbage", ctx: newContext("127.0.0.1", "5.6.7.8, unknown"), want: "127.0.0.1"},
+		{name: "no peer", ctx: context.Background(), want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, clientIP(tt.ctx, trusted))
+		})
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	trusted := parseTrustedProxies([]string{"::1", "192.168.0.0/16", "bad"})
+	assert.Len(t, trusted, 2)
+	assert.True(t, isTrusted("::1", trusted))
+	assert.True(t, isTrusted("192.168.1.1", trusted))
+	assert.False(t, isTrusted("127.0.0.1", trusted))
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/ArthurWang23/miniblog/internal/pkg/known"
 	"github.com/ArthurWang23/miniblog/pkg/errorsx"
 	"github.com/google/uuid"
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
@@ -35,33 +36,7 @@ import (
 
 func RequestIDInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-		var requestID string
-		md, _ := metadata.FromIncomingContext(ctx)
-		if requestIDs := md[known.XRequestID]; len(requestIDs) > 0 {
-			requestID = requestIDs[0]
-		}
-		// 如果请求id为空，则生成一个唯一的请求id
-		if requestID == "" {
-			requestID = uuid.New().String()
-			md.Append(known.XRequestID, requestID)
-		}
-
-		// 将元数据设为新的incoming context
-		ctx = metadata.NewIncomingContext(ctx, md)
-		// 将请求ID设置到响应的Header Metadata中
-		// SetHeader在grpc方法响应中添加元数据
-		// 仅设置数据，不会立即发送给客户端
-		// header metadata会在rpc响应返回时一并发送
-		grpc.SetHeader(ctx, md)
-
-		// 将请求 ID 添加到自定义的上下文中
-		// grpc请求的返回元数据过程中,已经包含了请求id
-		// 这里再次将请求id添加到grpc返回错误中，原因如下：
-		// 错误中包含请求id更易定位问题
-		// grpc元数据很少被处理
-
-		ctx = contextx.WithRequestID(ctx, requestID)
-
+		ctx, requestID := withRequestID(ctx)
 		res, err := handler(ctx, req)
 		if err != nil {
 			return res, errorsx.FromError(err).WithRequestID(requestID)
@@ -69,3 +44,44 @@ func RequestIDInterceptor() grpc.UnaryServerInterceptor {
 		return res, nil
 	}
 }
+
+// RequestIDStreamInterceptor 流式调用的RequestID拦截器
+func RequestIDStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, requestID := withRequestID(ss.Context())
+		wrapped := middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		if err := handler(srv, wrapped); err != nil {
+			return errorsx.FromError(err).WithRequestID(requestID)
+		}
+		return nil
+	}
+}
+
+func withRequestID(ctx context.Context) (context.Context, string) {
+	var requestID string
+	md, _ := metadata.FromIncomingContext(ctx)
+	if requestIDs := md[known.XRequestID]; len(requestIDs) > 0 {
+		requestID = requestIDs[0]
+	}
+	// 如果请求id为空，则生成一个唯一的请求id
+	if requestID == "" {
+		requestID = uuid.New().String()
+		md.Append(known.XRequestID, requestID)
+	}
+
+	// 将元数据设为新的incoming context
+	ctx = metadata.NewIncomingContext(ctx, md)
+	// 将请求ID设置到响应的Header Metadata中
+	// SetHeader在grpc方法响应中添加元数据
+	// 仅设置数据，不会立即发送给客户端
+	// header metadata会在rpc响应返回时一并发送
+	grpc.SetHeader(ctx, md)
+
+	// 将请求 ID 添加到自定义的上下文中
+	// grpc请求的返回元数据过程中,已经包含了请求id
+	// 这里再次将请求id添加到grpc返回错误中，原因如下：
+	// 错误中包含请求id更易定位问题
+	// grpc元数据很少被处理
+	return contextx.WithRequestID(ctx, requestID), requestID
+}
@@ -18,3 +18,22 @@ func ValidatorInterceptor(validator RequestValidator) grpc.UnaryServerIntercepto
 		return handler(ctx, rq)
 	}
 }
+
+// ValidatorStreamInterceptor 流式调用的参数校验拦截器，校验客户端发送的每一条消息
+func ValidatorStreamInterceptor(validator RequestValidator) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatedStream{ServerStream: ss, validator: validator})
+	}
+}
+
+type validatedStream struct {
+	grpc.ServerStream
+	validator RequestValidator
+}
+
+func (s *validatedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.validator.Validate(s.Context(), m)
+}
@@ -42,6 +42,14 @@ const (
 	UserID ResourceID = "user"
 	// 定义帖子资源标识符
 	PostID ResourceID = "post"
+	// 定义评论资源标识符
+	CommentID ResourceID = "comment"
+	// 定义媒体文件资源标识符
+	MediaID ResourceID = "media"
+	// 定义博文系列资源标识符
+	SeriesID ResourceID = "series"
+	// 定义通知资源标识符
+	NotificationID ResourceID = "notification"
 )
 
 // 将资源标识符转换为字符串
@@ -32,6 +32,7 @@ func NewGRPCGatewayServer(
 	grpcOptions *genericoptions.GRPCOptions,
 	tlsOptions *genericoptions.TLSOptions,
 	registerHandler func(mux *runtime.ServeMux, conn *grpc.ClientConn) error,
+	muxOptions ...runtime.ServeMuxOption,
 ) (*GRPCGatewayServer, error) {
 	var tlsConfig *tls.Config
 	if tlsOptions != nil && tlsOptions.UseTLS {
@@ -56,11 +57,12 @@ func NewGRPCGatewayServer(
 		log.Errorw("Failed to dial context", "err", err)
 		return nil, err
 	}
-	gwmux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+	muxOptions = append([]runtime.ServeMuxOption{runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
 		MarshalOptions: protojson.MarshalOptions{
 			UseEnumNumbers: true,
 		},
-	}))
+	})}, muxOptions...)
+	gwmux := runtime.NewServeMux(muxOptions...)
 	if err := registerHandler(gwmux, conn); err != nil {
 		log.Errorw("Failed to register handler", "err", err)
 		return nil, err
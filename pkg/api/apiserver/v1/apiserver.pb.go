@@ -44,206 +44,798 @@ var file_apiserver_v1_apiserver_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x61, 0x70, 0x69, 0x73, 0x65,
 	0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x17, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31,
-	0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xa2, 0x0e, 0x0a, 0x08,
-	0x4d, 0x69, 0x6e, 0x69, 0x42, 0x6c, 0x6f, 0x67, 0x12, 0x76, 0x0a, 0x07, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x7a, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x3e, 0x92, 0x41, 0x2b, 0x0a, 0x0c, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe6, 0xb2, 0xbb,
-	0xe7, 0x90, 0x86, 0x12, 0x12, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe5, 0x81, 0xa5, 0xe5, 0xba,
-	0xb7, 0xe6, 0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0x2a, 0x07, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a,
-	0x12, 0x65, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37,
-	0x92, 0x41, 0x23, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90,
-	0x86, 0x12, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95, 0x2a,
-	0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x3a, 0x01, 0x2a, 0x22,
-	0x06, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x89, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72,
-	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x92, 0x41, 0x2a,
-	0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c,
-	0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x2a, 0x0c, 0x52, 0x65,
-	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13,
-	0x3a, 0x01, 0x2a, 0x1a, 0x0e, 0x2f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x2d, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0xa5, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x92,
+	0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x61, 0x70, 0x69,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x67, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x18, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x61, 0x70, 0x69, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76,
+	0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x19, 0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x61, 0x70, 0x69,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xc5, 0x41, 0x0a,
+	0x08, 0x4d, 0x69, 0x6e, 0x69, 0x42, 0x6c, 0x6f, 0x67, 0x12, 0x76, 0x0a, 0x07, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x7a, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3e, 0x92, 0x41, 0x2b, 0x0a, 0x0c, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe6, 0xb2,
+	0xbb, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe5, 0x81, 0xa5, 0xe5,
+	0xba, 0xb7, 0xe6, 0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0x2a, 0x07, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x7a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x7a, 0x12, 0x65, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x10, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x37, 0x92, 0x41, 0x23, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7,
+	0x90, 0x86, 0x12, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0x99, 0xbb, 0xe5, 0xbd, 0x95,
+	0x2a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x3a, 0x01, 0x2a,
+	0x22, 0x06, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x89, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x92, 0x41,
+	0x2a, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12,
+	0x0c, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x2a, 0x0c, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x13, 0x3a, 0x01, 0x2a, 0x1a, 0x0e, 0x2f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x2d, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0xa5, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c,
+	0x92, 0x41, 0x2c, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90,
+	0x86, 0x12, 0x0c, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe5, 0xaf, 0x86, 0xe7, 0xa0, 0x81, 0x2a,
+	0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x1a, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x2d, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x7c, 0x0a, 0x0a,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x92, 0x41, 0x28, 0x0a, 0x0c,
+	0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88,
+	0x9b, 0xe5, 0xbb, 0xba, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x2a, 0x0a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22,
+	0x09, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0xa4, 0x01, 0x0a, 0x0a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x92, 0x41, 0x2e, 0x0a, 0x0c, 0xe7,
+	0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe6, 0x9b, 0xb4,
+	0xe6, 0x96, 0xb0, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a,
+	0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x30, 0x3a, 0x01, 0x2a, 0x5a, 0x17, 0x3a, 0x01, 0x2a, 0x32, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x1a, 0x12, 0x2f,
+	0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x7d, 0x12, 0x82, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x45, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7,
+	0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7,
+	0x2a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x14, 0x2a, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0x7c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x92, 0x41, 0x2b, 0x0a,
+	0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe8,
+	0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe4, 0xbf, 0xa1, 0xe6, 0x81,
+	0xaf, 0x2a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14,
+	0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x7d, 0x12, 0x79, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x92,
 	0x41, 0x2c, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86,
-	0x12, 0x0c, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xe5, 0xaf, 0x86, 0xe7, 0xa0, 0x81, 0x2a, 0x0e,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x1a, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x2d, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x7c, 0x0a, 0x0a, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe7,
-	0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0x9b,
-	0xe5, 0xbb, 0xba, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x2a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09,
-	0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x8b, 0x01, 0x0a, 0x0a, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x92, 0x41, 0x2e, 0x0a, 0x0c, 0xe7, 0x94,
-	0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe6, 0x9b, 0xb4, 0xe6,
-	0x96, 0xb0, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x0a,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
-	0x3a, 0x01, 0x2a, 0x1a, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0x82, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6,
-	0x88, 0xb7, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4,
-	0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x2a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x2a, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0x7c, 0x0a, 0x07,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x48, 0x92, 0x41, 0x2b, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xae, 0xa1,
-	0xe7, 0x90, 0x86, 0x12, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe7, 0x94, 0xa8, 0xe6, 0x88,
-	0xb7, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x12, 0x79, 0x0a, 0x08, 0x4c, 0x69,
-	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x40, 0x92, 0x41, 0x2c, 0x0a, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7,
-	0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe6, 0x89,
-	0x80, 0xe6, 0x9c, 0x89, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x2a, 0x08, 0x4c, 0x69, 0x73, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x7c, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
-	0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x3f, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7,
-	0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x96, 0x87,
-	0xe7, 0xab, 0xa0, 0x2a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f,
-	0x73, 0x74, 0x73, 0x12, 0x85, 0x01, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f,
-	0x73, 0x74, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x48, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae,
-	0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x96, 0x87, 0xe7,
-	0xab, 0xa0, 0x2a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x1a, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73,
-	0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x12, 0x7c, 0x0a, 0x0a, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x92, 0x41, 0x28, 0x0a, 0x0c, 0xe5,
-	0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0xa0,
-	0xe9, 0x99, 0xa4, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x2a, 0x09,
-	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x7c, 0x0a, 0x07, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x92,
-	0x41, 0x2b, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86,
-	0x12, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0xe4, 0xbf,
-	0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b,
-	0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x12, 0x77, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40,
-	0x92, 0x41, 0x2c, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90,
-	0x86, 0x12, 0x12, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe6,
-	0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73,
-	0x42, 0x98, 0x02, 0x92, 0x41, 0xda, 0x01, 0x12, 0xb0, 0x01, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x69,
-	0x62, 0x6c, 0x6f, 0x67, 0x20, 0x41, 0x50, 0x49, 0x22, 0x50, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x69,
-	0x62, 0x6c, 0x6f, 0x67, 0x12, 0x28, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x72, 0x74, 0x68, 0x75, 0x72, 0x57,
-	0x61, 0x6e, 0x67, 0x32, 0x33, 0x2f, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x67, 0x1a, 0x1a,
-	0x61, 0x72, 0x74, 0x68, 0x75, 0x72, 0x32, 0x38, 0x32, 0x36, 0x39, 0x37, 0x39, 0x31, 0x37, 0x36,
-	0x40, 0x67, 0x6d, 0x61, 0x69, 0x6c, 0x2e, 0x63, 0x6f, 0x6d, 0x2a, 0x49, 0x0a, 0x0b, 0x4d, 0x49,
-	0x54, 0x20, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x68, 0x74, 0x74, 0x70, 0x73,
-	0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x72,
-	0x74, 0x68, 0x75, 0x72, 0x57, 0x61, 0x6e, 0x67, 0x32, 0x33, 0x2f, 0x6d, 0x69, 0x6e, 0x69, 0x62,
-	0x6c, 0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x4c, 0x49,
-	0x43, 0x45, 0x4e, 0x53, 0x45, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01, 0x02, 0x32, 0x10, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
-	0x6e, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x72,
-	0x74, 0x68, 0x75, 0x72, 0x57, 0x61, 0x6e, 0x67, 0x32, 0x33, 0x2f, 0x6d, 0x69, 0x6e, 0x69, 0x62,
-	0x6c, 0x6f, 0x67, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x69, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x12, 0x12, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe7, 0x94,
+	0xa8, 0xe6, 0x88, 0xb7, 0x2a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x7c, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x92, 0x41,
+	0x28, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12,
+	0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0a, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a,
+	0x01, 0x2a, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x9e, 0x01,
+	0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x61, 0x92, 0x41, 0x28,
+	0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c,
+	0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0a, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01,
+	0x2a, 0x5a, 0x17, 0x3a, 0x01, 0x2a, 0x32, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x1a, 0x12, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x12, 0x7c,
+	0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x92, 0x41, 0x28,
+	0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c,
+	0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0a, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01,
+	0x2a, 0x2a, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x7c, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x48, 0x92, 0x41, 0x2b, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1,
+	0xe7, 0x90, 0x86, 0x12, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x96, 0x87, 0xe7, 0xab,
+	0xa0, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x2a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x12, 0x77, 0x0a, 0x08, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x40, 0x92, 0x41, 0x2c, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae,
+	0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe6, 0x89, 0x80, 0xe6,
+	0x9c, 0x89, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x12, 0x91, 0x01, 0x0a, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50,
+	0x6f, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x92, 0x41, 0x29, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae,
+	0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe6,
+	0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f,
+	0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x9b, 0x01, 0x0a, 0x0d, 0x55, 0x6e, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55,
+	0x92, 0x41, 0x2b, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90,
+	0x86, 0x12, 0x0c, 0xe6, 0x92, 0xa4, 0xe5, 0x9b, 0x9e, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a,
+	0x0d, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73,
+	0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x75, 0x6e, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x91, 0x01, 0x0a, 0x0b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x92, 0x41, 0x29, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a,
+	0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0xbd, 0x92, 0xe6, 0xa1,
+	0xa3, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
+	0x7d, 0x2f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x08, 0x4c, 0x69,
+	0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x4b, 0x92, 0x41, 0x26, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae,
+	0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0xe6, 0x96, 0x87, 0xe7,
+	0xab, 0xa0, 0x2a, 0x08, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73,
+	0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x6c, 0x69, 0x6b, 0x65, 0x12, 0x8c,
+	0x01, 0x0a, 0x0a, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x92, 0x41,
+	0x28, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12,
+	0x0c, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe7, 0x82, 0xb9, 0xe8, 0xb5, 0x9e, 0x2a, 0x0a, 0x55,
+	0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a,
+	0x01, 0x2a, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x75, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x12, 0x7d, 0x0a,
+	0x07, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69,
+	0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x49, 0x92, 0x41, 0x25, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae,
+	0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb6, 0xe6, 0x96, 0x87, 0xe7,
+	0xab, 0xa0, 0x2a, 0x07, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f,
+	0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x70, 0x69, 0x6e, 0x12, 0x87, 0x01, 0x0a,
+	0x09, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x92, 0x41, 0x27, 0x0a, 0x0c, 0xe5, 0x8d,
+	0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x8f, 0x96, 0xe6,
+	0xb6, 0x88, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb6, 0x2a, 0x09, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50,
+	0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d,
+	0x2f, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x12, 0xb4, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0x92, 0x41, 0x3b, 0x0a, 0x0c,
+	0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x18, 0xe5, 0x88,
+	0x97, 0xe5, 0x87, 0xba, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0xe5, 0x8e, 0x86, 0xe5, 0x8f, 0xb2,
+	0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x2a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12,
+	0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x44, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xb7, 0x01,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6b, 0x92, 0x41, 0x39, 0x0a,
+	0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x18, 0xe8,
+	0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0xe5, 0x8e, 0x86, 0xe5, 0x8f,
+	0xb2, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x2a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x44, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x12, 0xd2, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x7a, 0x92, 0x41, 0x3d, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1,
+	0xe7, 0x90, 0x86, 0x12, 0x18, 0xe6, 0x81, 0xa2, 0xe5, 0xa4, 0x8d, 0xe6, 0x96, 0x87, 0xe7, 0xab,
+	0xa0, 0xe5, 0x8e, 0x86, 0xe5, 0x8f, 0xb2, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x2a, 0x13, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a, 0x01, 0x2a, 0x22, 0x2f, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0xaf, 0x01, 0x0a,
+	0x11, 0x44, 0x69, 0x66, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x5d, 0x92, 0x41, 0x3b, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7,
+	0x90, 0x86, 0x12, 0x18, 0xe6, 0xaf, 0x94, 0xe8, 0xbe, 0x83, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0,
+	0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe5, 0xb7, 0xae, 0xe5, 0xbc, 0x82, 0x2a, 0x11, 0x44, 0x69,
+	0x66, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73,
+	0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x64, 0x69, 0x66, 0x66, 0x12, 0xa6,
+	0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57,
+	0x92, 0x41, 0x3d, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90,
+	0x86, 0x12, 0x1b, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe5, 0x9b, 0x9e, 0xe6, 0x94, 0xb6, 0xe7,
+	0xab, 0x99, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x73,
+	0x68, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x9d, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x92, 0x41, 0x35, 0x0a, 0x0c, 0xe5,
+	0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x18, 0xe6, 0x81, 0xa2,
+	0xe5, 0xa4, 0x8d, 0xe5, 0xb7, 0xb2, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0x9a, 0x84, 0xe6,
+	0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f,
+	0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x92, 0x41, 0x29, 0x0a, 0x0c, 0xe5,
+	0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0xaf, 0xbc,
+	0xe5, 0x87, 0xba, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x30, 0x01, 0x12, 0x8f, 0x01, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x92, 0x41, 0x29, 0x0a, 0x0c, 0xe5, 0x8d,
+	0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0xaf, 0xbc, 0xe5,
+	0x85, 0xa5, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x28, 0x01, 0x12, 0xb3, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12,
+	0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e,
+	0x92, 0x41, 0x39, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90,
+	0x86, 0x12, 0x15, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe5, 0xbe, 0x85, 0xe5, 0xae, 0xa1, 0xe6,
+	0xa0, 0xb8, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0xcc,
+	0x01, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x71, 0x92, 0x41, 0x38, 0x0a,
+	0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe5,
+	0xae, 0xa1, 0xe6, 0xa0, 0xb8, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe6, 0x96, 0x87, 0xe7, 0xab,
+	0xa0, 0x2a, 0x14, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a,
+	0x22, 0x2b, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6d, 0x6f, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0xca, 0x01,
+	0x0a, 0x13, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x72, 0x92, 0x41, 0x3a, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a,
+	0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x15, 0xe5, 0xae, 0xa1, 0xe6, 0xa0,
+	0xb8, 0xe4, 0xb8, 0x8d, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0,
+	0x2a, 0x13, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x64, 0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a, 0x22, 0x2a,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x44, 0x7d, 0x2f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x9a, 0x01, 0x0a, 0x0f, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1a,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x92, 0x41, 0x33, 0x0a, 0x0c, 0xe5, 0x85,
+	0xac, 0xe5, 0xbc, 0x80, 0xe8, 0xae, 0xbf, 0xe9, 0x97, 0xae, 0x12, 0x12, 0xe5, 0x88, 0x97, 0xe5,
+	0x87, 0xba, 0xe5, 0x85, 0xac, 0xe5, 0xbc, 0x80, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x9b, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55,
+	0x92, 0x41, 0x31, 0x0a, 0x0c, 0xe5, 0x85, 0xac, 0xe5, 0xbc, 0x80, 0xe8, 0xae, 0xbf, 0xe9, 0x97,
+	0xae, 0x12, 0x12, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0x85, 0xac, 0xe5, 0xbc, 0x80, 0xe6,
+	0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x50, 0x6f, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x44, 0x7d, 0x12, 0xa2, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79,
+	0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x92, 0x41,
+	0x3d, 0x0a, 0x0c, 0xe5, 0x85, 0xac, 0xe5, 0xbc, 0x80, 0xe8, 0xae, 0xbf, 0xe9, 0x97, 0xae, 0x12,
+	0x1e, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe6, 0xb0, 0xb8, 0xe4, 0xb9, 0x85, 0xe9, 0x93, 0xbe,
+	0xe6, 0x8e, 0xa5, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a,
+	0x0d, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x75, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x73, 0x6c, 0x75, 0x67, 0x7d, 0x12, 0x84, 0x01, 0x0a, 0x0b, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x92, 0x41, 0x29,
+	0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c,
+	0xe6, 0xa3, 0x80, 0xe7, 0xb4, 0xa2, 0xe6, 0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0b, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12,
+	0x10, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x12, 0x70, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x13, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x92, 0x41, 0x26, 0x0a, 0x0c, 0xe5,
+	0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0x97,
+	0xe5, 0x87, 0xba, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0x2a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x61, 0x67, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x76, 0x31, 0x2f, 0x74,
+	0x61, 0x67, 0x73, 0x12, 0x85, 0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x92, 0x41, 0x2a, 0x0a, 0x0c, 0xe5, 0x8d,
+	0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0x9b, 0xe5,
+	0xbb, 0xba, 0xe7, 0xb3, 0xbb, 0xe5, 0x88, 0x97, 0x2a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22,
+	0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0xab, 0x01, 0x0a, 0x0f,
+	0x41, 0x64, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x53, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f, 0x92, 0x41, 0x36, 0x0a, 0x0c, 0xe5,
+	0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x15, 0xe5, 0x90, 0x91,
+	0xe7, 0xb3, 0xbb, 0xe5, 0x88, 0x97, 0xe6, 0xb7, 0xbb, 0xe5, 0x8a, 0xa0, 0xe5, 0x8d, 0x9a, 0xe6,
+	0x96, 0x87, 0x2a, 0x0f, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x49, 0x44, 0x7d, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0xa0, 0x01, 0x0a, 0x0d, 0x52, 0x65,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x5a, 0x92, 0x41, 0x31, 0x0a, 0x0c, 0xe5, 0x8d, 0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1,
+	0xe7, 0x90, 0x86, 0x12, 0x12, 0xe8, 0xb0, 0x83, 0xe6, 0x95, 0xb4, 0xe7, 0xb3, 0xbb, 0xe5, 0x88,
+	0x97, 0xe9, 0xa1, 0xba, 0xe5, 0xba, 0x8f, 0x2a, 0x0d, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x1a,
+	0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x49, 0x44, 0x7d, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x81, 0x01, 0x0a,
+	0x09, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x92, 0x41, 0x27, 0x0a, 0x0c, 0xe5, 0x8d,
+	0x9a, 0xe5, 0xae, 0xa2, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe8, 0x8e, 0xb7, 0xe5,
+	0x8f, 0x96, 0xe7, 0xb3, 0xbb, 0xe5, 0x88, 0x97, 0x2a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x44, 0x7d,
+	0x12, 0x8b, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x92, 0x41, 0x2b, 0x0a, 0x0c, 0xe8, 0xaf,
+	0x84, 0xe8, 0xae, 0xba, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0x9b, 0xe5,
+	0xbb, 0xba, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba, 0x2a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a,
+	0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x8b,
+	0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x17, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x48, 0x92, 0x41, 0x2a, 0x0a, 0x0c, 0xe5, 0x85, 0xac, 0xe5, 0xbc, 0x80, 0xe8,
+	0xae, 0xbf, 0xe9, 0x97, 0xae, 0x12, 0x0c, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe8, 0xaf, 0x84,
+	0xe8, 0xae, 0xba, 0x2a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x94, 0x01, 0x0a,
+	0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x4e, 0x92, 0x41, 0x2b, 0x0a, 0x0c, 0xe8, 0xaf, 0x84, 0xe8, 0xae, 0xba,
+	0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe8, 0xaf,
+	0x84, 0xe8, 0xae, 0xba, 0x2a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x2a, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x7d, 0x12, 0x84, 0x01, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x6d,
+	0x61, 0x72, 0x6b, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x6f, 0x6b,
+	0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x92, 0x41, 0x29, 0x0a, 0x0c, 0xe6, 0x94, 0xb6, 0xe8, 0x97,
+	0x8f, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe6, 0x94, 0xb6, 0xe8, 0x97, 0x8f, 0xe6,
+	0x96, 0x87, 0xe7, 0xab, 0xa0, 0x2a, 0x0b, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61,
+	0x72, 0x6b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x96, 0x01, 0x0a, 0x0e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x19, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x92, 0x41, 0x2c, 0x0a, 0x0c, 0xe6, 0x94, 0xb6, 0xe8, 0x97,
+	0x8f, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe6,
+	0x94, 0xb6, 0xe8, 0x97, 0x8f, 0x2a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f,
+	0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x2a, 0x16, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x44, 0x7d, 0x12, 0x89, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b,
+	0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x92, 0x41, 0x2b, 0x0a,
+	0x0c, 0xe6, 0x94, 0xb6, 0xe8, 0x97, 0x8f, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5,
+	0x88, 0x97, 0xe5, 0x87, 0xba, 0xe6, 0x94, 0xb6, 0xe8, 0x97, 0x8f, 0x2a, 0x0d, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f,
+	0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12,
+	0x7c, 0x0a, 0x06, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x4b, 0x92, 0x41, 0x24, 0x0a, 0x0c, 0xe5, 0x85, 0xb3, 0xe6, 0xb3, 0xa8, 0xe7, 0xae, 0xa1,
+	0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x85, 0xb3, 0xe6, 0xb3, 0xa8, 0xe7, 0x94, 0xa8, 0xe6, 0x88,
+	0xb7, 0x2a, 0x06, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a,
+	0x01, 0x2a, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x86, 0x01,
+	0x0a, 0x08, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x92, 0x41, 0x26, 0x0a, 0x0c, 0xe5, 0x85, 0xb3, 0xe6,
+	0xb3, 0xa8, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88,
+	0xe5, 0x85, 0xb3, 0xe6, 0xb3, 0xa8, 0x2a, 0x08, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x75, 0x6e,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x98, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x92,
+	0x41, 0x2b, 0x0a, 0x0c, 0xe5, 0x85, 0xb3, 0xe6, 0xb3, 0xa8, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86,
+	0x12, 0x0c, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe7, 0xb2, 0x89, 0xe4, 0xb8, 0x9d, 0x2a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x7d, 0x2f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x73, 0x12, 0x98, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x69, 0x6e, 0x67, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x92, 0x41, 0x2b, 0x0a, 0x0c, 0xe5,
+	0x85, 0xb3, 0xe6, 0xb3, 0xa8, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c, 0xe5, 0x88, 0x97,
+	0xe5, 0x87, 0xba, 0xe5, 0x85, 0xb3, 0xe6, 0xb3, 0xa8, 0x2a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c,
+	0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x7d, 0x2f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x89, 0x01, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x92,
+	0x41, 0x32, 0x0a, 0x0c, 0xe5, 0x85, 0xb3, 0xe6, 0xb3, 0xa8, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86,
+	0x12, 0x15, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe5, 0x85, 0xb3, 0xe6, 0xb3, 0xa8, 0xe6, 0x97,
+	0xb6, 0xe9, 0x97, 0xb4, 0xe7, 0xba, 0xbf, 0x2a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x9d, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x92, 0x41, 0x2f,
+	0x0a, 0x0c, 0xe9, 0x80, 0x9a, 0xe7, 0x9f, 0xa5, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x0c,
+	0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe9, 0x80, 0x9a, 0xe7, 0x9f, 0xa5, 0x2a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xbb, 0x01, 0x0a, 0x15, 0x4d, 0x61, 0x72,
+	0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x61, 0x64, 0x12, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x92, 0x41, 0x39, 0x0a, 0x0c, 0xe9, 0x80,
+	0x9a, 0xe7, 0x9f, 0xa5, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x12, 0xe6, 0xa0, 0x87, 0xe8,
+	0xae, 0xb0, 0xe9, 0x80, 0x9a, 0xe7, 0x9f, 0xa5, 0xe5, 0xb7, 0xb2, 0xe8, 0xaf, 0xbb, 0x2a, 0x15,
+	0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16,
+	0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x12, 0xd7, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55, 0x6e,
+	0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e,
+	0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6a, 0x92, 0x41, 0x41, 0x0a, 0x0c, 0xe9, 0x80, 0x9a, 0xe7, 0x9f,
+	0xa5, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x12, 0x15, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6,
+	0x9c, 0xaa, 0xe8, 0xaf, 0xbb, 0xe9, 0x80, 0x9a, 0xe7, 0x9f, 0xa5, 0xe6, 0x95, 0xb0, 0x2a, 0x1a,
+	0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20,
+	0x12, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x2d, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x42, 0x0a, 0x0b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12,
+	0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x28, 0x01, 0x42, 0x98, 0x02, 0x92, 0x41, 0xda, 0x01, 0x12, 0xb0, 0x01, 0x0a, 0x0c,
+	0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x67, 0x20, 0x41, 0x50, 0x49, 0x22, 0x50, 0x0a, 0x08,
+	0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x67, 0x12, 0x28, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a,
+	0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x72, 0x74,
+	0x68, 0x75, 0x72, 0x57, 0x61, 0x6e, 0x67, 0x32, 0x33, 0x2f, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c,
+	0x6f, 0x67, 0x1a, 0x1a, 0x61, 0x72, 0x74, 0x68, 0x75, 0x72, 0x32, 0x38, 0x32, 0x36, 0x39, 0x37,
+	0x39, 0x31, 0x37, 0x36, 0x40, 0x67, 0x6d, 0x61, 0x69, 0x6c, 0x2e, 0x63, 0x6f, 0x6d, 0x2a, 0x49,
+	0x0a, 0x0b, 0x4d, 0x49, 0x54, 0x20, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x68,
+	0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x41, 0x72, 0x74, 0x68, 0x75, 0x72, 0x57, 0x61, 0x6e, 0x67, 0x32, 0x33, 0x2f, 0x6d,
+	0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x2f, 0x6d, 0x61, 0x69,
+	0x6e, 0x2f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01,
+	0x02, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x41, 0x72, 0x74, 0x68, 0x75, 0x72, 0x57, 0x61, 0x6e, 0x67, 0x32, 0x33, 0x2f, 0x6d,
+	0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x70, 0x69, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_apiserver_v1_apiserver_proto_goTypes = []any{
-	(*emptypb.Empty)(nil),          // 0: google.protobuf.Empty
-	(*LoginRequest)(nil),           // 1: v1.LoginRequest
-	(*RefreshTokenRequest)(nil),    // 2: v1.RefreshTokenRequest
-	(*ChangePasswordRequest)(nil),  // 3: v1.ChangePasswordRequest
-	(*CreateUserRequest)(nil),      // 4: v1.CreateUserRequest
-	(*UpdateUserRequest)(nil),      // 5: v1.UpdateUserRequest
-	(*DeleteUserRequest)(nil),      // 6: v1.DeleteUserRequest
-	(*GetUserRequest)(nil),         // 7: v1.GetUserRequest
-	(*ListUsersRequest)(nil),       // 8: v1.ListUsersRequest
-	(*CreatePostRequest)(nil),      // 9: v1.CreatePostRequest
-	(*UpdatePostRequest)(nil),      // 10: v1.UpdatePostRequest
-	(*DeletePostRequest)(nil),      // 11: v1.DeletePostRequest
-	(*GetPostRequest)(nil),         // 12: v1.GetPostRequest
-	(*ListPostRequest)(nil),        // 13: v1.ListPostRequest
-	(*HealthzResponse)(nil),        // 14: v1.HealthzResponse
-	(*LoginResponse)(nil),          // 15: v1.LoginResponse
-	(*RefreshTokenResponse)(nil),   // 16: v1.RefreshTokenResponse
-	(*ChangePasswordResponse)(nil), // 17: v1.ChangePasswordResponse
-	(*CreateUserResponse)(nil),     // 18: v1.CreateUserResponse
-	(*UpdateUserResponse)(nil),     // 19: v1.UpdateUserResponse
-	(*DeleteUserResponse)(nil),     // 20: v1.DeleteUserResponse
-	(*GetUserResponse)(nil),        // 21: v1.GetUserResponse
-	(*ListUsersResponse)(nil),      // 22: v1.ListUsersResponse
-	(*CreatePostResponse)(nil),     // 23: v1.CreatePostResponse
-	(*UpdatePostResponse)(nil),     // 24: v1.UpdatePostResponse
-	(*DeletePostResponse)(nil),     // 25: v1.DeletePostResponse
-	(*GetPostResponse)(nil),        // 26: v1.GetPostResponse
-	(*ListPostResponse)(nil),       // 27: v1.ListPostResponse
+	(*emptypb.Empty)(nil),                      // 0: google.protobuf.Empty
+	(*LoginRequest)(nil),                       // 1: v1.LoginRequest
+	(*RefreshTokenRequest)(nil),                // 2: v1.RefreshTokenRequest
+	(*ChangePasswordRequest)(nil),              // 3: v1.ChangePasswordRequest
+	(*CreateUserRequest)(nil),                  // 4: v1.CreateUserRequest
+	(*UpdateUserRequest)(nil),                  // 5: v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),                  // 6: v1.DeleteUserRequest
+	(*GetUserRequest)(nil),                     // 7: v1.GetUserRequest
+	(*ListUsersRequest)(nil),                   // 8: v1.ListUsersRequest
+	(*CreatePostRequest)(nil),                  // 9: v1.CreatePostRequest
+	(*UpdatePostRequest)(nil),                  // 10: v1.UpdatePostRequest
+	(*DeletePostRequest)(nil),                  // 11: v1.DeletePostRequest
+	(*GetPostRequest)(nil),                     // 12: v1.GetPostRequest
+	(*ListPostRequest)(nil),                    // 13: v1.ListPostRequest
+	(*PublishPostRequest)(nil),                 // 14: v1.PublishPostRequest
+	(*UnpublishPostRequest)(nil),               // 15: v1.UnpublishPostRequest
+	(*ArchivePostRequest)(nil),                 // 16: v1.ArchivePostRequest
+	(*LikePostRequest)(nil),                    // 17: v1.LikePostRequest
+	(*UnlikePostRequest)(nil),                  // 18: v1.UnlikePostRequest
+	(*PinPostRequest)(nil),                     // 19: v1.PinPostRequest
+	(*UnpinPostRequest)(nil),                   // 20: v1.UnpinPostRequest
+	(*ListPostRevisionsRequest)(nil),           // 21: v1.ListPostRevisionsRequest
+	(*GetPostRevisionRequest)(nil),             // 22: v1.GetPostRevisionRequest
+	(*RestorePostRevisionRequest)(nil),         // 23: v1.RestorePostRevisionRequest
+	(*DiffPostRevisionsRequest)(nil),           // 24: v1.DiffPostRevisionsRequest
+	(*ListDeletedPostsRequest)(nil),            // 25: v1.ListDeletedPostsRequest
+	(*RestorePostRequest)(nil),                 // 26: v1.RestorePostRequest
+	(*ExportPostsRequest)(nil),                 // 27: v1.ExportPostsRequest
+	(*ImportPostsRequest)(nil),                 // 28: v1.ImportPostsRequest
+	(*ListModeratedPostsRequest)(nil),          // 29: v1.ListModeratedPostsRequest
+	(*ApproveModeratedPostRequest)(nil),        // 30: v1.ApproveModeratedPostRequest
+	(*RejectModeratedPostRequest)(nil),         // 31: v1.RejectModeratedPostRequest
+	(*ListPublicPostsRequest)(nil),             // 32: v1.ListPublicPostsRequest
+	(*GetPublicPostRequest)(nil),               // 33: v1.GetPublicPostRequest
+	(*GetPostBySlugRequest)(nil),               // 34: v1.GetPostBySlugRequest
+	(*SearchPostsRequest)(nil),                 // 35: v1.SearchPostsRequest
+	(*ListTagsRequest)(nil),                    // 36: v1.ListTagsRequest
+	(*CreateSeriesRequest)(nil),                // 37: v1.CreateSeriesRequest
+	(*AddPostToSeriesRequest)(nil),             // 38: v1.AddPostToSeriesRequest
+	(*ReorderSeriesRequest)(nil),               // 39: v1.ReorderSeriesRequest
+	(*GetSeriesRequest)(nil),                   // 40: v1.GetSeriesRequest
+	(*CreateCommentRequest)(nil),               // 41: v1.CreateCommentRequest
+	(*ListCommentsRequest)(nil),                // 42: v1.ListCommentsRequest
+	(*DeleteCommentRequest)(nil),               // 43: v1.DeleteCommentRequest
+	(*AddBookmarkRequest)(nil),                 // 44: v1.AddBookmarkRequest
+	(*RemoveBookmarkRequest)(nil),              // 45: v1.RemoveBookmarkRequest
+	(*ListBookmarksRequest)(nil),               // 46: v1.ListBookmarksRequest
+	(*FollowRequest)(nil),                      // 47: v1.FollowRequest
+	(*UnfollowRequest)(nil),                    // 48: v1.UnfollowRequest
+	(*ListFollowersRequest)(nil),               // 49: v1.ListFollowersRequest
+	(*ListFollowingRequest)(nil),               // 50: v1.ListFollowingRequest
+	(*GetTimelineRequest)(nil),                 // 51: v1.GetTimelineRequest
+	(*ListNotificationsRequest)(nil),           // 52: v1.ListNotificationsRequest
+	(*MarkNotificationsReadRequest)(nil),       // 53: v1.MarkNotificationsReadRequest
+	(*GetUnreadNotificationCountRequest)(nil),  // 54: v1.GetUnreadNotificationCountRequest
+	(*UploadMediaRequest)(nil),                 // 55: v1.UploadMediaRequest
+	(*HealthzResponse)(nil),                    // 56: v1.HealthzResponse
+	(*LoginResponse)(nil),                      // 57: v1.LoginResponse
+	(*RefreshTokenResponse)(nil),               // 58: v1.RefreshTokenResponse
+	(*ChangePasswordResponse)(nil),             // 59: v1.ChangePasswordResponse
+	(*CreateUserResponse)(nil),                 // 60: v1.CreateUserResponse
+	(*UpdateUserResponse)(nil),                 // 61: v1.UpdateUserResponse
+	(*DeleteUserResponse)(nil),                 // 62: v1.DeleteUserResponse
+	(*GetUserResponse)(nil),                    // 63: v1.GetUserResponse
+	(*ListUsersResponse)(nil),                  // 64: v1.ListUsersResponse
+	(*CreatePostResponse)(nil),                 // 65: v1.CreatePostResponse
+	(*UpdatePostResponse)(nil),                 // 66: v1.UpdatePostResponse
+	(*DeletePostResponse)(nil),                 // 67: v1.DeletePostResponse
+	(*GetPostResponse)(nil),                    // 68: v1.GetPostResponse
+	(*ListPostResponse)(nil),                   // 69: v1.ListPostResponse
+	(*PublishPostResponse)(nil),                // 70: v1.PublishPostResponse
+	(*UnpublishPostResponse)(nil),              // 71: v1.UnpublishPostResponse
+	(*ArchivePostResponse)(nil),                // 72: v1.ArchivePostResponse
+	(*LikePostResponse)(nil),                   // 73: v1.LikePostResponse
+	(*UnlikePostResponse)(nil),                 // 74: v1.UnlikePostResponse
+	(*PinPostResponse)(nil),                    // 75: v1.PinPostResponse
+	(*UnpinPostResponse)(nil),                  // 76: v1.UnpinPostResponse
+	(*ListPostRevisionsResponse)(nil),          // 77: v1.ListPostRevisionsResponse
+	(*GetPostRevisionResponse)(nil),            // 78: v1.GetPostRevisionResponse
+	(*RestorePostRevisionResponse)(nil),        // 79: v1.RestorePostRevisionResponse
+	(*DiffPostRevisionsResponse)(nil),          // 80: v1.DiffPostRevisionsResponse
+	(*ListDeletedPostsResponse)(nil),           // 81: v1.ListDeletedPostsResponse
+	(*RestorePostResponse)(nil),                // 82: v1.RestorePostResponse
+	(*ExportPostsResponse)(nil),                // 83: v1.ExportPostsResponse
+	(*ImportPostsResponse)(nil),                // 84: v1.ImportPostsResponse
+	(*ListModeratedPostsResponse)(nil),         // 85: v1.ListModeratedPostsResponse
+	(*ApproveModeratedPostResponse)(nil),       // 86: v1.ApproveModeratedPostResponse
+	(*RejectModeratedPostResponse)(nil),        // 87: v1.RejectModeratedPostResponse
+	(*ListPublicPostsResponse)(nil),            // 88: v1.ListPublicPostsResponse
+	(*GetPublicPostResponse)(nil),              // 89: v1.GetPublicPostResponse
+	(*GetPostBySlugResponse)(nil),              // 90: v1.GetPostBySlugResponse
+	(*SearchPostsResponse)(nil),                // 91: v1.SearchPostsResponse
+	(*ListTagsResponse)(nil),                   // 92: v1.ListTagsResponse
+	(*CreateSeriesResponse)(nil),               // 93: v1.CreateSeriesResponse
+	(*AddPostToSeriesResponse)(nil),            // 94: v1.AddPostToSeriesResponse
+	(*ReorderSeriesResponse)(nil),              // 95: v1.ReorderSeriesResponse
+	(*GetSeriesResponse)(nil),                  // 96: v1.GetSeriesResponse
+	(*CreateCommentResponse)(nil),              // 97: v1.CreateCommentResponse
+	(*ListCommentsResponse)(nil),               // 98: v1.ListCommentsResponse
+	(*DeleteCommentResponse)(nil),              // 99: v1.DeleteCommentResponse
+	(*AddBookmarkResponse)(nil),                // 100: v1.AddBookmarkResponse
+	(*RemoveBookmarkResponse)(nil),             // 101: v1.RemoveBookmarkResponse
+	(*ListBookmarksResponse)(nil),              // 102: v1.ListBookmarksResponse
+	(*FollowResponse)(nil),                     // 103: v1.FollowResponse
+	(*UnfollowResponse)(nil),                   // 104: v1.UnfollowResponse
+	(*ListFollowersResponse)(nil),              // 105: v1.ListFollowersResponse
+	(*ListFollowingResponse)(nil),              // 106: v1.ListFollowingResponse
+	(*GetTimelineResponse)(nil),                // 107: v1.GetTimelineResponse
+	(*ListNotificationsResponse)(nil),          // 108: v1.ListNotificationsResponse
+	(*MarkNotificationsReadResponse)(nil),      // 109: v1.MarkNotificationsReadResponse
+	(*GetUnreadNotificationCountResponse)(nil), // 110: v1.GetUnreadNotificationCountResponse
+	(*UploadMediaResponse)(nil),                // 111: v1.UploadMediaResponse
 }
 var file_apiserver_v1_apiserver_proto_depIdxs = []int32{
-	0,  // 0: v1.MiniBlog.Healthz:input_type -> google.protobuf.Empty
-	1,  // 1: v1.MiniBlog.Login:input_type -> v1.LoginRequest
-	2,  // 2: v1.MiniBlog.RefreshToken:input_type -> v1.RefreshTokenRequest
-	3,  // 3: v1.MiniBlog.ChangePassword:input_type -> v1.ChangePasswordRequest
-	4,  // 4: v1.MiniBlog.CreateUser:input_type -> v1.CreateUserRequest
-	5,  // 5: v1.MiniBlog.UpdateUser:input_type -> v1.UpdateUserRequest
-	6,  // 6: v1.MiniBlog.DeleteUser:input_type -> v1.DeleteUserRequest
-	7,  // 7: v1.MiniBlog.GetUser:input_type -> v1.GetUserRequest
-	8,  // 8: v1.MiniBlog.ListUser:input_type -> v1.ListUsersRequest
-	9,  // 9: v1.MiniBlog.CreatePost:input_type -> v1.CreatePostRequest
-	10, // 10: v1.MiniBlog.UpdatePost:input_type -> v1.UpdatePostRequest
-	11, // 11: v1.MiniBlog.DeletePost:input_type -> v1.DeletePostRequest
-	12, // 12: v1.MiniBlog.GetPost:input_type -> v1.GetPostRequest
-	13, // 13: v1.MiniBlog.ListPost:input_type -> v1.ListPostRequest
-	14, // 14: v1.MiniBlog.Healthz:output_type -> v1.HealthzResponse
-	15, // 15: v1.MiniBlog.Login:output_type -> v1.LoginResponse
-	16, // 16: v1.MiniBlog.RefreshToken:output_type -> v1.RefreshTokenResponse
-	17, // 17: v1.MiniBlog.ChangePassword:output_type -> v1.ChangePasswordResponse
-	18, // 18: v1.MiniBlog.CreateUser:output_type -> v1.CreateUserResponse
-	19, // 19: v1.MiniBlog.UpdateUser:output_type -> v1.UpdateUserResponse
-	20, // 20: v1.MiniBlog.DeleteUser:output_type -> v1.DeleteUserResponse
-	21, // 21: v1.MiniBlog.GetUser:output_type -> v1.GetUserResponse
-	22, // 22: v1.MiniBlog.ListUser:output_type -> v1.ListUsersResponse
-	23, // 23: v1.MiniBlog.CreatePost:output_type -> v1.CreatePostResponse
-	24, // 24: v1.MiniBlog.UpdatePost:output_type -> v1.UpdatePostResponse
-	25, // 25: v1.MiniBlog.DeletePost:output_type -> v1.DeletePostResponse
-	26, // 26: v1.MiniBlog.GetPost:output_type -> v1.GetPostResponse
-	27, // 27: v1.MiniBlog.ListPost:output_type -> v1.ListPostResponse
-	14, // [14:28] is the sub-list for method output_type
-	0,  // [0:14] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: v1.MiniBlog.Healthz:input_type -> google.protobuf.Empty
+	1,   // 1: v1.MiniBlog.Login:input_type -> v1.LoginRequest
+	2,   // 2: v1.MiniBlog.RefreshToken:input_type -> v1.RefreshTokenRequest
+	3,   // 3: v1.MiniBlog.ChangePassword:input_type -> v1.ChangePasswordRequest
+	4,   // 4: v1.MiniBlog.CreateUser:input_type -> v1.CreateUserRequest
+	5,   // 5: v1.MiniBlog.UpdateUser:input_type -> v1.UpdateUserRequest
+	6,   // 6: v1.MiniBlog.DeleteUser:input_type -> v1.DeleteUserRequest
+	7,   // 7: v1.MiniBlog.GetUser:input_type -> v1.GetUserRequest
+	8,   // 8: v1.MiniBlog.ListUser:input_type -> v1.ListUsersRequest
+	9,   // 9: v1.MiniBlog.CreatePost:input_type -> v1.CreatePostRequest
+	10,  // 10: v1.MiniBlog.UpdatePost:input_type -> v1.UpdatePostRequest
+	11,  // 11: v1.MiniBlog.DeletePost:input_type -> v1.DeletePostRequest
+	12,  // 12: v1.MiniBlog.GetPost:input_type -> v1.GetPostRequest
+	13,  // 13: v1.MiniBlog.ListPost:input_type -> v1.ListPostRequest
+	14,  // 14: v1.MiniBlog.PublishPost:input_type -> v1.PublishPostRequest
+	15,  // 15: v1.MiniBlog.UnpublishPost:input_type -> v1.UnpublishPostRequest
+	16,  // 16: v1.MiniBlog.ArchivePost:input_type -> v1.ArchivePostRequest
+	17,  // 17: v1.MiniBlog.LikePost:input_type -> v1.LikePostRequest
+	18,  // 18: v1.MiniBlog.UnlikePost:input_type -> v1.UnlikePostRequest
+	19,  // 19: v1.MiniBlog.PinPost:input_type -> v1.PinPostRequest
+	20,  // 20: v1.MiniBlog.UnpinPost:input_type -> v1.UnpinPostRequest
+	21,  // 21: v1.MiniBlog.ListPostRevisions:input_type -> v1.ListPostRevisionsRequest
+	22,  // 22: v1.MiniBlog.GetPostRevision:input_type -> v1.GetPostRevisionRequest
+	23,  // 23: v1.MiniBlog.RestorePostRevision:input_type -> v1.RestorePostRevisionRequest
+	24,  // 24: v1.MiniBlog.DiffPostRevisions:input_type -> v1.DiffPostRevisionsRequest
+	25,  // 25: v1.MiniBlog.ListDeletedPosts:input_type -> v1.ListDeletedPostsRequest
+	26,  // 26: v1.MiniBlog.RestorePost:input_type -> v1.RestorePostRequest
+	27,  // 27: v1.MiniBlog.ExportPosts:input_type -> v1.ExportPostsRequest
+	28,  // 28: v1.MiniBlog.ImportPosts:input_type -> v1.ImportPostsRequest
+	29,  // 29: v1.MiniBlog.ListModeratedPosts:input_type -> v1.ListModeratedPostsRequest
+	30,  // 30: v1.MiniBlog.ApproveModeratedPost:input_type -> v1.ApproveModeratedPostRequest
+	31,  // 31: v1.MiniBlog.RejectModeratedPost:input_type -> v1.RejectModeratedPostRequest
+	32,  // 32: v1.MiniBlog.ListPublicPosts:input_type -> v1.ListPublicPostsRequest
+	33,  // 33: v1.MiniBlog.GetPublicPost:input_type -> v1.GetPublicPostRequest
+	34,  // 34: v1.MiniBlog.GetPostBySlug:input_type -> v1.GetPostBySlugRequest
+	35,  // 35: v1.MiniBlog.SearchPosts:input_type -> v1.SearchPostsRequest
+	36,  // 36: v1.MiniBlog.ListTags:input_type -> v1.ListTagsRequest
+	37,  // 37: v1.MiniBlog.CreateSeries:input_type -> v1.CreateSeriesRequest
+	38,  // 38: v1.MiniBlog.AddPostToSeries:input_type -> v1.AddPostToSeriesRequest
+	39,  // 39: v1.MiniBlog.ReorderSeries:input_type -> v1.ReorderSeriesRequest
+	40,  // 40: v1.MiniBlog.GetSeries:input_type -> v1.GetSeriesRequest
+	41,  // 41: v1.MiniBlog.CreateComment:input_type -> v1.CreateCommentRequest
+	42,  // 42: v1.MiniBlog.ListComments:input_type -> v1.ListCommentsRequest
+	43,  // 43: v1.MiniBlog.DeleteComment:input_type -> v1.DeleteCommentRequest
+	44,  // 44: v1.MiniBlog.AddBookmark:input_type -> v1.AddBookmarkRequest
+	45,  // 45: v1.MiniBlog.RemoveBookmark:input_type -> v1.RemoveBookmarkRequest
+	46,  // 46: v1.MiniBlog.ListBookmarks:input_type -> v1.ListBookmarksRequest
+	47,  // 47: v1.MiniBlog.Follow:input_type -> v1.FollowRequest
+	48,  // 48: v1.MiniBlog.Unfollow:input_type -> v1.UnfollowRequest
+	49,  // 49: v1.MiniBlog.ListFollowers:input_type -> v1.ListFollowersRequest
+	50,  // 50: v1.MiniBlog.ListFollowing:input_type -> v1.ListFollowingRequest
+	51,  // 51: v1.MiniBlog.GetTimeline:input_type -> v1.GetTimelineRequest
+	52,  // 52: v1.MiniBlog.ListNotifications:input_type -> v1.ListNotificationsRequest
+	53,  // 53: v1.MiniBlog.MarkNotificationsRead:input_type -> v1.MarkNotificationsReadRequest
+	54,  // 54: v1.MiniBlog.GetUnreadNotificationCount:input_type -> v1.GetUnreadNotificationCountRequest
+	55,  // 55: v1.MiniBlog.UploadMedia:input_type -> v1.UploadMediaRequest
+	56,  // 56: v1.MiniBlog.Healthz:output_type -> v1.HealthzResponse
+	57,  // 57: v1.MiniBlog.Login:output_type -> v1.LoginResponse
+	58,  // 58: v1.MiniBlog.RefreshToken:output_type -> v1.RefreshTokenResponse
+	59,  // 59: v1.MiniBlog.ChangePassword:output_type -> v1.ChangePasswordResponse
+	60,  // 60: v1.MiniBlog.CreateUser:output_type -> v1.CreateUserResponse
+	61,  // 61: v1.MiniBlog.UpdateUser:output_type -> v1.UpdateUserResponse
+	62,  // 62: v1.MiniBlog.DeleteUser:output_type -> v1.DeleteUserResponse
+	63,  // 63: v1.MiniBlog.GetUser:output_type -> v1.GetUserResponse
+	64,  // 64: v1.MiniBlog.ListUser:output_type -> v1.ListUsersResponse
+	65,  // 65: v1.MiniBlog.CreatePost:output_type -> v1.CreatePostResponse
+	66,  // 66: v1.MiniBlog.UpdatePost:output_type -> v1.UpdatePostResponse
+	67,  // 67: v1.MiniBlog.DeletePost:output_type -> v1.DeletePostResponse
+	68,  // 68: v1.MiniBlog.GetPost:output_type -> v1.GetPostResponse
+	69,  // 69: v1.MiniBlog.ListPost:output_type -> v1.ListPostResponse
+	70,  // 70: v1.MiniBlog.PublishPost:output_type -> v1.PublishPostResponse
+	71,  // 71: v1.MiniBlog.UnpublishPost:output_type -> v1.UnpublishPostResponse
+	72,  // 72: v1.MiniBlog.ArchivePost:output_type -> v1.ArchivePostResponse
+	73,  // 73: v1.MiniBlog.LikePost:output_type -> v1.LikePostResponse
+	74,  // 74: v1.MiniBlog.UnlikePost:output_type -> v1.UnlikePostResponse
+	75,  // 75: v1.MiniBlog.PinPost:output_type -> v1.PinPostResponse
+	76,  // 76: v1.MiniBlog.UnpinPost:output_type -> v1.UnpinPostResponse
+	77,  // 77: v1.MiniBlog.ListPostRevisions:output_type -> v1.ListPostRevisionsResponse
+	78,  // 78: v1.MiniBlog.GetPostRevision:output_type -> v1.GetPostRevisionResponse
+	79,  // 79: v1.MiniBlog.RestorePostRevision:output_type -> v1.RestorePostRevisionResponse
+	80,  // 80: v1.MiniBlog.DiffPostRevisions:output_type -> v1.DiffPostRevisionsResponse
+	81,  // 81: v1.MiniBlog.ListDeletedPosts:output_type -> v1.ListDeletedPostsResponse
+	82,  // 82: v1.MiniBlog.RestorePost:output_type -> v1.RestorePostResponse
+	83,  // 83: v1.MiniBlog.ExportPosts:output_type -> v1.ExportPostsResponse
+	84,  // 84: v1.MiniBlog.ImportPosts:output_type -> v1.ImportPostsResponse
+	85,  // 85: v1.MiniBlog.ListModeratedPosts:output_type -> v1.ListModeratedPostsResponse
+	86,  // 86: v1.MiniBlog.ApproveModeratedPost:output_type -> v1.ApproveModeratedPostResponse
+	87,  // 87: v1.MiniBlog.RejectModeratedPost:output_type -> v1.RejectModeratedPostResponse
+	88,  // 88: v1.MiniBlog.ListPublicPosts:output_type -> v1.ListPublicPostsResponse
+	89,  // 89: v1.MiniBlog.GetPublicPost:output_type -> v1.GetPublicPostResponse
+	90,  // 90: v1.MiniBlog.GetPostBySlug:output_type -> v1.GetPostBySlugResponse
+	91,  // 91: v1.MiniBlog.SearchPosts:output_type -> v1.SearchPostsResponse
+	92,  // 92: v1.MiniBlog.ListTags:output_type -> v1.ListTagsResponse
+	93,  // 93: v1.MiniBlog.CreateSeries:output_type -> v1.CreateSeriesResponse
+	94,  // 94: v1.MiniBlog.AddPostToSeries:output_type -> v1.AddPostToSeriesResponse
+	95,  // 95: v1.MiniBlog.ReorderSeries:output_type -> v1.ReorderSeriesResponse
+	96,  // 96: v1.MiniBlog.GetSeries:output_type -> v1.GetSeriesResponse
+	97,  // 97: v1.MiniBlog.CreateComment:output_type -> v1.CreateCommentResponse
+	98,  // 98: v1.MiniBlog.ListComments:output_type -> v1.ListCommentsResponse
+	99,  // 99: v1.MiniBlog.DeleteComment:output_type -> v1.DeleteCommentResponse
+	100, // 100: v1.MiniBlog.AddBookmark:output_type -> v1.AddBookmarkResponse
+	101, // 101: v1.MiniBlog.RemoveBookmark:output_type -> v1.RemoveBookmarkResponse
+	102, // 102: v1.MiniBlog.ListBookmarks:output_type -> v1.ListBookmarksResponse
+	103, // 103: v1.MiniBlog.Follow:output_type -> v1.FollowResponse
+	104, // 104: v1.MiniBlog.Unfollow:output_type -> v1.UnfollowResponse
+	105, // 105: v1.MiniBlog.ListFollowers:output_type -> v1.ListFollowersResponse
+	106, // 106: v1.MiniBlog.ListFollowing:output_type -> v1.ListFollowingResponse
+	107, // 107: v1.MiniBlog.GetTimeline:output_type -> v1.GetTimelineResponse
+	108, // 108: v1.MiniBlog.ListNotifications:output_type -> v1.ListNotificationsResponse
+	109, // 109: v1.MiniBlog.MarkNotificationsRead:output_type -> v1.MarkNotificationsReadResponse
+	110, // 110: v1.MiniBlog.GetUnreadNotificationCount:output_type -> v1.GetUnreadNotificationCountResponse
+	111, // 111: v1.MiniBlog.UploadMedia:output_type -> v1.UploadMediaResponse
+	56,  // [56:112] is the sub-list for method output_type
+	0,   // [0:56] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_apiserver_v1_apiserver_proto_init() }
@@ -254,6 +846,14 @@ func file_apiserver_v1_apiserver_proto_init() {
 	file_apiserver_v1_healthz_proto_init()
 	file_apiserver_v1_post_proto_init()
 	file_apiserver_v1_user_proto_init()
+	file_apiserver_v1_tag_proto_init()
+	file_apiserver_v1_comment_proto_init()
+	file_apiserver_v1_media_proto_init()
+	file_apiserver_v1_series_proto_init()
+	file_apiserver_v1_moderation_proto_init()
+	file_apiserver_v1_bookmark_proto_init()
+	file_apiserver_v1_follow_proto_init()
+	file_apiserver_v1_notification_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -14,9 +14,18 @@ var (
 	_ *wrapperspb.BoolValue
 )
 
+func (x *PostAuthor) Default() {
+}
+
 func (x *Post) Default() {
 }
 
+func (x *PostSeriesNav) Default() {
+}
+
+func (x *PostLink) Default() {
+}
+
 func (x *CreatePostRequest) Default() {
 }
 
@@ -46,3 +55,126 @@ func (x *ListPostRequest) Default() {
 
 func (x *ListPostResponse) Default() {
 }
+
+func (x *PublishPostRequest) Default() {
+}
+
+func (x *PublishPostResponse) Default() {
+}
+
+func (x *UnpublishPostRequest) Default() {
+}
+
+func (x *UnpublishPostResponse) Default() {
+}
+
+func (x *ArchivePostRequest) Default() {
+}
+
+func (x *ArchivePostResponse) Default() {
+}
+
+func (x *ListDeletedPostsRequest) Default() {
+}
+
+func (x *ListDeletedPostsResponse) Default() {
+}
+
+func (x *RestorePostRequest) Default() {
+}
+
+func (x *RestorePostResponse) Default() {
+}
+
+func (x *ListPublicPostsRequest) Default() {
+}
+
+func (x *ListPublicPostsResponse) Default() {
+}
+
+func (x *GetPublicPostRequest) Default() {
+}
+
+func (x *GetPublicPostResponse) Default() {
+}
+
+func (x *SearchPostsRequest) Default() {
+}
+
+func (x *SearchPostResult) Default() {
+}
+
+func (x *SearchPostsResponse) Default() {
+}
+
+func (x *LikePostRequest) Default() {
+}
+
+func (x *LikePostResponse) Default() {
+}
+
+func (x *UnlikePostRequest) Default() {
+}
+
+func (x *UnlikePostResponse) Default() {
+}
+
+func (x *PinPostRequest) Default() {
+}
+
+func (x *PinPostResponse) Default() {
+}
+
+func (x *UnpinPostRequest) Default() {
+}
+
+func (x *UnpinPostResponse) Default() {
+}
+
+func (x *PostRevision) Default() {
+}
+
+func (x *ListPostRevisionsRequest) Default() {
+}
+
+func (x *ListPostRevisionsResponse) Default() {
+}
+
+func (x *GetPostRevisionRequest) Default() {
+}
+
+func (x *GetPostRevisionResponse) Default() {
+}
+
+func (x *RestorePostRevisionRequest) Default() {
+}
+
+func (x *RestorePostRevisionResponse) Default() {
+}
+
+func (x *DiffPostRevisionsRequest) Default() {
+}
+
+func (x *DiffPostRevisionsResponse) Default() {
+}
+
+func (x *GetPostBySlugRequest) Default() {
+}
+
+func (x *GetPostBySlugResponse) Default() {
+}
+
+func (x *ExportPostsRequest) Default() {
+}
+
+func (x *ExportPostsResponse) Default() {
+}
+
+func (x *ImportPostsRequest) Default() {
+}
+
+func (x *ImportPostError) Default() {
+}
+
+func (x *ImportPostsResponse) Default() {
+}
@@ -32,6 +32,35 @@ func TypeConverters() []copier.TypeConverter {
 				return s.AsTime(), nil
 			},
 		},
+		{
+			SrcType: &time.Time{},
+			DstType: &timestamppb.Timestamp{},
+			Fn: func(src interface{}) (dst interface{}, err error) {
+				s, ok := src.(*time.Time)
+				if !ok {
+					return nil, errors.New("source type not matching")
+				}
+				if s == nil {
+					return (*timestamppb.Timestamp)(nil), nil
+				}
+				return timestamppb.New(*s), nil
+			},
+		},
+		{
+			SrcType: &timestamppb.Timestamp{},
+			DstType: &time.Time{},
+			Fn: func(src interface{}) (dst interface{}, err error) {
+				s, ok := src.(*timestamppb.Timestamp)
+				if !ok {
+					return nil, errors.New("source type not matching")
+				}
+				if s == nil {
+					return (*time.Time)(nil), nil
+				}
+				t := s.AsTime()
+				return &t, nil
+			},
+		},
 	}
 }
 
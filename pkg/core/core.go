@@ -2,10 +2,15 @@ package core
 
 import (
 	"context"
+	"io"
 	"net/http"
 
 	"github.com/ArthurWang23/miniblog/pkg/errorsx"
 	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 // 验证函数的类型，用于对绑定的数据结构进行验证
@@ -46,6 +51,54 @@ func HandleUriRequest[T any, R any](c *gin.Context, handler Handler[T, R], valid
 	HandleRequest(c, c.ShouldBindUri, handler, validators...)
 }
 
+// 按照grpc-gateway的规则将查询参数绑定到protobuf消息
+// gin的form绑定不支持google.protobuf.Timestamp等类型，请求中包含这类字段时使用该函数
+func HandleProtoQueryRequest[T any, R any](c *gin.Context, handler Handler[T, R], validators ...Validator[T]) {
+	binder := func(obj any) error {
+		msg, ok := obj.(proto.Message)
+		if !ok {
+			return c.ShouldBindQuery(obj)
+		}
+		return runtime.PopulateQueryParameters(msg, c.Request.URL.Query(), utilities.NewDoubleArray(nil))
+	}
+	HandleRequest(c, binder, handler, validators...)
+}
+
+// 处理同时包含路径参数和查询参数的请求，例如分页查询某个资源的子资源
+func HandleUriQueryRequest[T any, R any](c *gin.Context, handler Handler[T, R], validators ...Validator[T]) {
+	binder := func(obj any) error {
+		if err := c.ShouldBindUri(obj); err != nil {
+			return err
+		}
+		return c.ShouldBindQuery(obj)
+	}
+	HandleRequest(c, binder, handler, validators...)
+}
+
+// 处理同时包含路径参数和JSON请求体的请求，例如修改某个资源的子资源
+// 后绑定路径参数，保证请求体中的同名字段不会覆盖路径参数
+func HandleUriJSONRequest[T any, R any](c *gin.Context, handler Handler[T, R], validators ...Validator[T]) {
+	binder := func(obj any) error {
+		if err := c.ShouldBindJSON(obj); err != nil {
+			return err
+		}
+		return c.ShouldBindUri(obj)
+	}
+	HandleRequest(c, binder, handler, validators...)
+}
+
+// 按照grpc-gateway的规则将JSON请求体绑定到protobuf消息
+// 请求体中的Timestamp按照RFC3339字符串解析，与OpenAPI文档保持一致
+func HandleProtoJSONRequest[T any, R any](c *gin.Context, handler Handler[T, R], validators ...Validator[T]) {
+	HandleRequest(c, protoJSONBinder(c), handler, validators...)
+}
+
+// 按照grpc-gateway的规则将JSON请求体绑定到protobuf消息，再绑定路径参数
+// gin的JSON绑定不支持google.protobuf.FieldMask、Timestamp等在JSON中使用字符串表示的类型，PATCH请求使用该函数
+func HandleUriProtoJSONRequest[T any, R any](c *gin.Context, handler Handler[T, R], validators ...Validator[T]) {
+	HandleRequest(c, uriProtoJSONBinder(c), handler, validators...)
+}
+
 // 通用的请求处理函数
 // 负责绑定请求数据，执行验证，并调用实际的业务处理逻辑函数
 func HandleRequest[T any, R any](c *gin.Context, binder Binder, handler Handler[T, R], validators ...Validator[T]) {
@@ -74,6 +127,35 @@ func ShouldBindUri[T any](c *gin.Context, rq *T, validators ...Validator[T]) err
 	return ReadRequest(c, rq, c.ShouldBindUri, validators...)
 }
 
+func ShouldBindUriProtoJSON[T any](c *gin.Context, rq *T, validators ...Validator[T]) error {
+	return ReadRequest(c, rq, uriProtoJSONBinder(c), validators...)
+}
+
+func uriProtoJSONBinder(c *gin.Context) Binder {
+	bindJSON := protoJSONBinder(c)
+	return func(obj any) error {
+		if err := bindJSON(obj); err != nil {
+			return err
+		}
+		return c.ShouldBindUri(obj)
+	}
+}
+
+// protoJSONBinder 使用protojson解析请求体，obj不是protobuf消息时使用gin的JSON绑定
+func protoJSONBinder(c *gin.Context) Binder {
+	return func(obj any) error {
+		msg, ok := obj.(proto.Message)
+		if !ok {
+			return c.ShouldBindJSON(obj)
+		}
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			return err
+		}
+		return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(body, msg)
+	}
+}
+
 // 用于绑定和验证请求数据的通用工具函数
 // 调用绑定函数绑定请求数据
 // 如果目标实现了Default接口，则调用Default方法设置默认值
@@ -65,6 +65,19 @@ func (s *Store[T]) Update(ctx context.Context, obj *T) error {
 	return nil
 }
 
+// UpdateColumns 只更新obj中columns指定的列，零值也会写入，用于部分更新
+// 与Update的整行Save不同，不会覆盖其他请求并发修改的列
+func (s *Store[T]) UpdateColumns(ctx context.Context, obj *T, columns ...string) error {
+	if len(columns) == 0 {
+		return nil
+	}
+	if err := s.db(ctx).Model(obj).Select(columns).Updates(obj).Error; err != nil {
+		s.logger.Error(ctx, err, "Failed to update object columns in database", "object", obj, "columns", columns)
+		return err
+	}
+	return nil
+}
+
 func (s *Store[T]) Delete(ctx context.Context, opts *where.Options) error {
 	err := s.db(ctx, opts).Delete(new(T)).Error
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
@@ -84,7 +97,11 @@ func (s *Store[T]) Get(ctx context.Context, opts *where.Options) (*T, error) {
 }
 
 func (s *Store[T]) List(ctx context.Context, opts *where.Options) (count int64, ret []*T, err error) {
-	err = s.db(ctx, opts).Order("id desc").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	err = s.db(ctx, opts).Order("id desc").Find(&ret).Error
+	if err == nil && !opts.SkipCount {
+		// 总数不受游标分页条件的影响
+		err = s.db(ctx, opts.WithoutCursor()).Model(new(T)).Offset(-1).Limit(-1).Count(&count).Error
+	}
 	if err != nil {
 		s.logger.Error(ctx, err, "Failed to list objects from database", "conditions", opts)
 	}
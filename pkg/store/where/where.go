@@ -2,6 +2,8 @@ package where
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -58,6 +60,12 @@ type Options struct {
 	Clauses []clause.Expression
 	// queries to be executed
 	Queries []Query
+	// 排序字段，Store.List会在最后追加按id倒序排列，保证结果顺序稳定
+	Orders []clause.OrderByColumn
+	// 游标分页条件，只作用于查询结果，统计总数时会被忽略
+	Cursor []clause.Expression
+	// 为true时List不统计总数，适用于游标分页等不需要总数的场景
+	SkipCount bool
 }
 
 var registeredTenant Tenant
@@ -175,6 +183,63 @@ func (whr *Options) Q(query interface{}, args ...interface{}) *Options {
 	return whr
 }
 
+// R 添加时间范围条件，只返回column在[from, to)之间的记录，from或to为nil时不限制对应的边界
+func (whr *Options) R(column string, from, to *time.Time) *Options {
+	col := clause.Column{Table: clause.CurrentTable, Name: column}
+	if from != nil {
+		whr.C(clause.Gte{Column: col, Value: *from})
+	}
+	if to != nil {
+		whr.C(clause.Lt{Column: col, Value: *to})
+	}
+	return whr
+}
+
+// S 添加排序字段
+func (whr *Options) S(orders ...clause.OrderByColumn) *Options {
+	whr.Orders = append(whr.Orders, orders...)
+	return whr
+}
+
+// K 设置游标分页条件，只返回排在上一页最后一条记录之后的记录
+// values是上一页最后一条记录中通过S设置的排序字段的值，与Orders一一对应，lastID是该记录的id
+// Store.List最后按id倒序排列，因此条件展开为
+// (c1 > v1) OR (c1 = v1 AND c2 > v2) OR ... OR (c1 = v1 AND c2 = v2 AND ... AND id < lastID)，降序字段使用 <
+// 与offset分页相比不需要扫描前面的记录，翻页过程中插入新记录也不会导致结果错位
+func (whr *Options) K(lastID int64, values ...any) *Options {
+	whr.Offset = 0
+	var ors, eqs []clause.Expression
+	for i, order := range whr.Orders {
+		if i >= len(values) {
+			break
+		}
+		var cmp clause.Expression = clause.Gt{Column: order.Column, Value: values[i]}
+		if order.Desc {
+			cmp = clause.Lt{Column: order.Column, Value: values[i]}
+		}
+		ors = append(ors, clause.And(append(slices.Clone(eqs), cmp)...))
+		eqs = append(eqs, clause.Eq{Column: order.Column, Value: values[i]})
+	}
+	id := clause.Column{Table: clause.CurrentTable, Name: "id"}
+	ors = append(ors, clause.And(append(eqs, clause.Lt{Column: id, Value: lastID})...))
+	// 单独的OrConditions会与前面的条件用OR连接，需要用And包裹成一个整体
+	whr.Cursor = append(whr.Cursor, clause.And(clause.Or(ors...)))
+	return whr
+}
+
+// WithoutCursor 返回去掉游标分页条件后的查询条件，用于统计总数
+func (whr *Options) WithoutCursor() *Options {
+	ret := *whr
+	ret.Cursor = nil
+	return &ret
+}
+
+// NoCount 设置List不统计总数
+func (whr *Options) NoCount() *Options {
+	whr.SkipCount = true
+	return whr
+}
+
 // retrieve the value associated with the registered tenant using the provided context
 func (whr *Options) T(ctx context.Context) *Options {
 	if registeredTenant.Key != "" && registeredTenant.ValueFunc != nil {
@@ -198,11 +263,17 @@ func (whr *Options) F(kvs ...any) *Options {
 }
 
 func (whr *Options) Where(db *gorm.DB) *gorm.DB {
+	// 不修改whr.Clauses，同一个Options可以多次用于查询
+	clauses := slices.Clone(whr.Clauses)
 	for _, query := range whr.Queries {
 		conds := db.Statement.BuildCondition(query.Query, query.Args...)
-		whr.Clauses = append(whr.Clauses, conds...)
+		clauses = append(clauses, conds...)
+	}
+	clauses = append(clauses, whr.Cursor...)
+	for _, order := range whr.Orders {
+		db = db.Order(order)
 	}
-	return db.Where(whr.Filters).Clauses(whr.Clauses...).Offset(whr.Offset).Limit(whr.Limit)
+	return db.Where(whr.Filters).Clauses(clauses...).Offset(whr.Offset).Limit(whr.Limit)
 }
 
 // 提供一些便捷函数，用来快速创建一个指定了某类查询条件的*Options结构体实例
@@ -222,6 +293,10 @@ func C(conds ...clause.Expression) *Options {
 	return NewWhere().C(conds...)
 }
 
+func S(orders ...clause.OrderByColumn) *Options {
+	return NewWhere().S(orders...)
+}
+
 func T(ctx context.Context) *Options {
 	return NewWhere().F(registeredTenant.Key, registeredTenant.ValueFunc(ctx))
 }
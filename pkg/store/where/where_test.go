@@ -0,0 +1,35 @@
+package where
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type item struct {
+	ID        int64
+	Status    int
+	CreatedAt int64
+}
+
+func buildSQL(t *testing.T, whr *Options) string {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{DryRun: true})
+	require.NoError(t, err)
+	stmt := whr.Where(db.Model(&item{})).Find(&[]item{}).Statement
+	return strings.TrimSpace(stmt.SQL.String())
+}
+
+func TestCursorIsAndedWithQueries(t *testing.T) {
+	whr := S(clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true}).
+		Q("status IN ?", []int{1, 2}).
+		K(7, int64(100))
+
+	assert.Equal(t,
+		"SELECT * FROM `items` WHERE status IN (?,?) AND (`created_at` < ? OR (`created_at` = ? AND `items`.`id` < ?)) ORDER BY `created_at` DESC",
+		buildSQL(t, whr))
+}